@@ -0,0 +1,9 @@
+data "zammad_group" "users" {
+  name = "Users"
+}
+
+resource "zammad_user_group_access" "support" {
+  user_id  = 12
+  group_id = data.zammad_group.users.id
+  access   = ["full"]
+}
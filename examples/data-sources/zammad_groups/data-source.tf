@@ -0,0 +1,5 @@
+data "zammad_groups" "all" {}
+
+output "active_group_ids" {
+  value = { for g in data.zammad_groups.all.groups : g.name => g.id if g.active }
+}
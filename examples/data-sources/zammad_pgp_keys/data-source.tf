@@ -0,0 +1,6 @@
+# Warns during plan about keys expiring within the next 30 days.
+data "zammad_pgp_keys" "all" {}
+
+output "pgp_expiry" {
+  value = { for k in data.zammad_pgp_keys.all.keys : k.name => k.expires_at if k.expires_at != null }
+}
@@ -0,0 +1,10 @@
+data "zammad_role" "agent" {
+  name = "Agent"
+}
+
+data "zammad_users" "agents" {
+  query    = "*"
+  role_ids = [data.zammad_role.agent.id]
+  active   = true
+  limit    = 100
+}
@@ -0,0 +1,5 @@
+data "zammad_roles" "all" {}
+
+output "role_ids" {
+  value = { for r in data.zammad_roles.all.roles : r.name => r.id }
+}
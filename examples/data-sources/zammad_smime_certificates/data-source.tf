@@ -0,0 +1,8 @@
+# Warns during plan about certificates expiring within the next 60 days.
+data "zammad_smime_certificates" "all" {
+  expiry_warning_days = 60
+}
+
+output "smime_expiry" {
+  value = { for c in data.zammad_smime_certificates.all.certificates : c.subject => c.not_after }
+}
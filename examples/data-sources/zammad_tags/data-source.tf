@@ -0,0 +1,12 @@
+data "zammad_tags" "all" {}
+
+# Tags which are not part of the canonical vocabulary.
+output "unmanaged_tags" {
+  value = setsubtract(data.zammad_tags.all.tags[*].name, keys(zammad_tag.canonical))
+}
+
+resource "zammad_tag" "canonical" {
+  for_each = toset(["billing", "outage", "feature-request"])
+
+  name = each.value
+}
@@ -0,0 +1,7 @@
+data "zammad_ticket_state" "closed" {
+  name = "closed"
+}
+
+output "closed_state_id" {
+  value = data.zammad_ticket_state.closed.id
+}
@@ -0,0 +1,5 @@
+data "zammad_ticket_states" "all" {}
+
+output "ticket_state_ids" {
+  value = { for s in data.zammad_ticket_states.all.ticket_states : s.name => s.id if s.active }
+}
@@ -0,0 +1,10 @@
+# The ten most recently created open tickets tagged as outage.
+data "zammad_tickets" "outages" {
+  query    = "state.name:open AND tags:outage"
+  sort_by  = "created_at"
+  order_by = "desc"
+}
+
+output "outage_numbers" {
+  value = data.zammad_tickets.outages.tickets[*].number
+}
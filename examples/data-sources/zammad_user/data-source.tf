@@ -0,0 +1,13 @@
+data "zammad_user" "oncall" {
+  email = "oncall@example.com"
+}
+
+resource "zammad_ticket" "incident" {
+  title       = "Database failover"
+  group_id    = 1
+  customer_id = 1
+  owner_id    = data.zammad_user.oncall.id
+  article = {
+    body = "Failover triggered by monitoring."
+  }
+}
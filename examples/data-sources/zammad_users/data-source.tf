@@ -0,0 +1,12 @@
+# Active agents of the support organization.
+data "zammad_users" "support_agents" {
+  query           = "*"
+  role_ids        = [2]
+  organization_id = 5
+  active          = true
+  limit           = 100
+}
+
+output "support_agent_emails" {
+  value = data.zammad_users.support_agents.users[*].email
+}
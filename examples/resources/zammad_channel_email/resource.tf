@@ -0,0 +1,29 @@
+variable "support_mail_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "zammad_channel_email" "support" {
+  email    = "support@example.com"
+  realname = "Example Support"
+  group_id = 1
+
+  inbound = {
+    adapter        = "imap"
+    host           = "imap.example.com"
+    port           = 993
+    ssl            = "ssl"
+    user           = "support@example.com"
+    password       = var.support_mail_password
+    keep_on_server = true
+  }
+
+  outbound = {
+    adapter  = "smtp"
+    host     = "smtp.example.com"
+    port     = 587
+    ssl      = "starttls"
+    user     = "support@example.com"
+    password = var.support_mail_password
+  }
+}
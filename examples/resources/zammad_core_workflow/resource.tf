@@ -0,0 +1,30 @@
+resource "zammad_ticket_priority" "urgent" {
+  name = "4 urgent"
+}
+
+# Make the group mandatory and hide the pending time of urgent tickets while
+# they are created.
+resource "zammad_core_workflow" "urgent" {
+  name   = "urgent tickets"
+  object = "Ticket"
+
+  preferences = {
+    screen = ["create_middle"]
+  }
+
+  condition_selected = {
+    "ticket.priority_id" = {
+      operator = "is"
+      value    = [zammad_ticket_priority.urgent.id]
+    }
+  }
+
+  perform = {
+    "ticket.group_id" = {
+      operators = ["set_mandatory"]
+    }
+    "ticket.pending_time" = {
+      operators = ["hide"]
+    }
+  }
+}
@@ -0,0 +1,8 @@
+variable "github_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "zammad_integration_github" "github" {
+  api_token = var.github_token
+}
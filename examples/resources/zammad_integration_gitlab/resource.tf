@@ -0,0 +1,10 @@
+variable "gitlab_token" {
+  type      = string
+  sensitive = true
+}
+
+# Self-hosted GitLab
+resource "zammad_integration_gitlab" "gitlab" {
+  endpoint  = "https://gitlab.example.com/api/graphql"
+  api_token = var.gitlab_token
+}
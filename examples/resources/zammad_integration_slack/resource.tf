@@ -0,0 +1,16 @@
+variable "slack_webhook" {
+  type      = string
+  sensitive = true
+}
+
+resource "zammad_integration_slack" "slack" {
+  items = [
+    {
+      webhook   = var.slack_webhook
+      username  = "Zammad"
+      channel   = "#support"
+      group_ids = [1]
+      types     = ["create", "escalation", "escalation_warning"]
+    },
+  ]
+}
@@ -0,0 +1,16 @@
+resource "zammad_knowledge_base" "help" {
+  color_highlight = "#1c6ea4"
+  custom_address  = "/help"
+  show_feed_icon  = true
+  primary_locale  = "en-us"
+
+  locales = {
+    "en-us" = {
+      title       = "Help center"
+      footer_note = "Example Inc."
+    }
+    "de-de" = {
+      title = "Hilfe"
+    }
+  }
+}
@@ -0,0 +1,25 @@
+resource "zammad_knowledge_base_answer" "welcome" {
+  knowledge_base_id = zammad_knowledge_base.help.id
+  category_id       = zammad_knowledge_base_category.getting_started.id
+  publish_state     = "public"
+  publish_at        = "2023-01-09T08:00:00Z"
+  body_format       = "markdown"
+
+  translations = {
+    "en-us" = {
+      title = "Welcome"
+      body  = file("${path.module}/answers/welcome.en.md")
+    }
+    "de-de" = {
+      title = "Willkommen"
+      body  = file("${path.module}/answers/welcome.de.md")
+    }
+  }
+
+  # Referenced in the bodies as ![Login](login.png).
+  attachments = {
+    "login.png" = {
+      path = "${path.module}/answers/login.png"
+    }
+  }
+}
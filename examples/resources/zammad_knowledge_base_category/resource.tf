@@ -0,0 +1,13 @@
+resource "zammad_knowledge_base_category" "getting_started" {
+  knowledge_base_id = zammad_knowledge_base.help.id
+  category_icon     = "f115"
+
+  translations = {
+    "en-us" = {
+      title = "Getting started"
+    }
+    "de-de" = {
+      title = "Erste Schritte"
+    }
+  }
+}
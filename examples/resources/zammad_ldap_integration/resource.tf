@@ -0,0 +1,3 @@
+resource "zammad_ldap_integration" "ldap" {
+  enabled = true
+}
@@ -0,0 +1,34 @@
+variable "ldap_bind_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "zammad_ldap_source" "ad" {
+  name      = "Active Directory"
+  host      = "ad.example.com"
+  port      = 636
+  ssl       = "ssl"
+  bind_user = "CN=zammad,OU=Service Accounts,DC=example,DC=com"
+  bind_pw   = var.ldap_bind_password
+  base_dn   = "DC=example,DC=com"
+
+  user_filter = "(&(objectClass=user)(memberOf=CN=Support,OU=Groups,DC=example,DC=com))"
+  user_uid    = "samaccountname"
+  user_attributes = {
+    givenname       = "firstname"
+    sn              = "lastname"
+    mail            = "email"
+    telephonenumber = "phone"
+  }
+
+  group_filter = "(objectClass=group)"
+  group_role_map = {
+    "CN=Support,OU=Groups,DC=example,DC=com"        = [2]
+    "CN=Support Admins,OU=Groups,DC=example,DC=com" = [1, 2]
+  }
+  unassigned_users = "skip_sync"
+}
+
+resource "zammad_ldap_integration" "ldap" {
+  depends_on = [zammad_ldap_source.ad]
+}
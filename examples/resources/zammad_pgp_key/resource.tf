@@ -0,0 +1,5 @@
+resource "zammad_pgp_key" "support" {
+  key          = file("${path.module}/support.asc")
+  passphrase   = var.support_key_passphrase
+  domain_alias = "example.com"
+}
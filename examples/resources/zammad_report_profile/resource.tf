@@ -0,0 +1,29 @@
+# Reports of the tickets of a single customer.
+resource "zammad_report_profile" "customer" {
+  name = "Customer: ${zammad_organization.customer.name}"
+
+  condition = {
+    "ticket.organization_id" = {
+      operator      = "is"
+      pre_condition = "specific"
+      value         = [zammad_organization.customer.id]
+    }
+  }
+}
+
+# Tickets of the last 30 days mentioning an outage.
+resource "zammad_report_profile" "recent_outages" {
+  name = "Recent outages"
+
+  condition = {
+    "ticket.created_at" = {
+      operator = "within last (relative)"
+      value    = ["30"]
+      range    = "day"
+    }
+    "ticket.title" = {
+      operator = "contains"
+      value    = ["outage"]
+    }
+  }
+}
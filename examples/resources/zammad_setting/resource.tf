@@ -0,0 +1,9 @@
+resource "zammad_setting" "product_name" {
+  name       = "product_name"
+  value_json = jsonencode("Helpdesk")
+}
+
+resource "zammad_setting" "password_min_size" {
+  name       = "password_min_size"
+  value_json = jsonencode(12)
+}
@@ -0,0 +1,5 @@
+resource "zammad_smime_certificate" "support" {
+  certificate        = file("${path.module}/support.crt")
+  private_key        = file("${path.module}/support.key")
+  private_key_secret = var.support_key_passphrase
+}
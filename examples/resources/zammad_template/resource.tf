@@ -0,0 +1,10 @@
+resource "zammad_template" "password_reset" {
+  name = "Password reset"
+
+  options = {
+    "ticket.title"       = "Password reset"
+    "ticket.priority_id" = "2"
+    "ticket.tags"        = "password, self-service"
+    "article.body"       = "Please reset my password."
+  }
+}
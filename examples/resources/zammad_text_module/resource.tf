@@ -0,0 +1,9 @@
+# One text module per markdown file in the text_modules directory, named
+# after the file.
+resource "zammad_text_module" "canned" {
+  for_each = fileset("${path.module}/text_modules", "*.md")
+
+  name           = trimsuffix(each.value, ".md")
+  content        = file("${path.module}/text_modules/${each.value}")
+  content_format = "markdown"
+}
@@ -0,0 +1,15 @@
+resource "zammad_ticket" "known_issues" {
+  title       = "Known issues"
+  group_id    = 1
+  customer_id = 1
+  tags        = ["known-issue"]
+
+  article = {
+    subject  = "Known issues"
+    body     = "Add an internal note for every known issue."
+    internal = true
+  }
+
+  # Keep the history of the ticket when it is removed from the configuration.
+  close_on_destroy = true
+}
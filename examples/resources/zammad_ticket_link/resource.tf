@@ -0,0 +1,6 @@
+# Link the incident to the problem ticket it is caused by.
+resource "zammad_ticket_link" "incident_problem" {
+  link_type                 = "parent"
+  link_object_source_number = zammad_ticket.problem.number
+  link_object_target_value  = zammad_ticket.incident.id
+}
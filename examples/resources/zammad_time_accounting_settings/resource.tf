@@ -0,0 +1,17 @@
+resource "zammad_time_accounting_settings" "this" {
+  unit  = "hour"
+  types = true
+
+  # Only account time on tickets of customers we bill.
+  selector = {
+    "organization.id" = {
+      operator      = "is"
+      pre_condition = "specific"
+      value         = [zammad_organization.customer.id]
+    }
+  }
+}
+
+resource "zammad_time_accounting_type" "consulting" {
+  name = "Consulting"
+}
@@ -0,0 +1,11 @@
+# Rotate with: terraform apply -replace=zammad_user_access_token.ci
+resource "zammad_user_access_token" "ci" {
+  name        = "ci"
+  permissions = ["ticket.agent"]
+  expires_at  = "2025-12-31"
+}
+
+output "ci_token" {
+  value     = zammad_user_access_token.ci.token
+  sensitive = true
+}
@@ -0,0 +1,9 @@
+# The billing team only manages access to its own group, access of the agents
+# to other groups is left untouched.
+resource "zammad_user_group_access" "billing" {
+  for_each = toset(["12", "14"])
+
+  user_id  = tonumber(each.value)
+  group_id = 3
+  access   = ["read", "create", "change"]
+}
@@ -0,0 +1,12 @@
+variable "teams_webhook" {
+  type      = string
+  sensitive = true
+}
+
+# Posts ticket notifications to a Microsoft Teams channel when used in a
+# trigger.
+resource "zammad_webhook" "teams" {
+  name                     = "Microsoft Teams"
+  endpoint                 = var.teams_webhook
+  pre_defined_webhook_type = "MicrosoftTeams"
+}
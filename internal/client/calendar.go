@@ -0,0 +1,117 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type CalendarBusinessDay struct {
+	Active     bool       `json:"active"`
+	Timeframes [][]string `json:"timeframes"`
+}
+
+type CalendarPublicHoliday struct {
+	Active  bool   `json:"active"`
+	Summary string `json:"summary"`
+	Feed    string `json:"feed,omitempty"`
+}
+
+type Calendar struct {
+	ID             int                              `json:"id,omitempty"`
+	Name           string                           `json:"name"`
+	Timezone       string                           `json:"timezone"`
+	BusinessHours  map[string]CalendarBusinessDay   `json:"business_hours"`
+	PublicHolidays map[string]CalendarPublicHoliday `json:"public_holidays"`
+	IcalURL        string                           `json:"ical_url"`
+	Default        bool                             `json:"default"`
+	Note           string                           `json:"note"`
+	CreatedAt      string                           `json:"created_at,omitempty"`
+	UpdatedAt      string                           `json:"updated_at,omitempty"`
+	CreatedByID    int                              `json:"created_by_id,omitempty"`
+	UpdatedByID    int                              `json:"updated_by_id,omitempty"`
+}
+
+func (c *Client) CreateCalendar(cal *Calendar) (*Calendar, error) {
+	rb, err := json.Marshal(cal)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/calendars", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newcal := &Calendar{}
+	err = json.Unmarshal(body, newcal)
+	if err != nil {
+		return nil, err
+	}
+	return newcal, nil
+}
+
+func (c *Client) GetCalendar(id int) (*Calendar, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/calendars/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newcal := &Calendar{}
+	err = json.Unmarshal(body, newcal)
+	if err != nil {
+		return nil, err
+	}
+	return newcal, nil
+}
+
+func (c *Client) UpdateCalendar(cal *Calendar) (*Calendar, error) {
+	rb, err := json.Marshal(cal)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/calendars/"+strconv.Itoa(cal.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newcal := &Calendar{}
+	err = json.Unmarshal(body, newcal)
+	if err != nil {
+		return nil, err
+	}
+	return newcal, nil
+}
+
+func (c *Client) DeleteCalendar(cal *Calendar) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/calendars/"+strconv.Itoa(cal.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
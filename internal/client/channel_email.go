@@ -0,0 +1,255 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// channelEmailTimeout is the timeout of the probes and the verification.
+// Zammad waits for the test mail to arrive in the inbound account, which
+// takes minutes with some mail servers.
+const channelEmailTimeout = 5 * time.Minute
+
+type ChannelEmail struct {
+	ID        int                 `json:"id,omitempty"`
+	GroupID   int                 `json:"group_id"`
+	Active    bool                `json:"active"`
+	Options   ChannelEmailOptions `json:"options"`
+	StatusIn  string              `json:"status_in,omitempty"`
+	StatusOut string              `json:"status_out,omitempty"`
+	CreatedAt string              `json:"created_at,omitempty"`
+	UpdatedAt string              `json:"updated_at,omitempty"`
+}
+
+type ChannelEmailOptions struct {
+	Inbound  ChannelEmailAccount `json:"inbound"`
+	Outbound ChannelEmailAccount `json:"outbound"`
+}
+
+// ChannelEmailAccount is the inbound (imap, pop3) or outbound (smtp,
+// sendmail) side of an email channel.
+type ChannelEmailAccount struct {
+	Adapter string                     `json:"adapter"`
+	Options ChannelEmailAccountOptions `json:"options"`
+}
+
+type ChannelEmailAccountOptions struct {
+	Host     string      `json:"host,omitempty"`
+	Port     json.Number `json:"port,omitempty"`
+	User     string      `json:"user,omitempty"`
+	Password string      `json:"password,omitempty"`
+	// SSL is ssl, starttls or off for inbound accounts and a boolean for
+	// outbound accounts.
+	SSL                interface{} `json:"ssl,omitempty"`
+	EnableStartTLSAuto *bool       `json:"enable_starttls_auto,omitempty"`
+	Folder             string      `json:"folder,omitempty"`
+	KeepOnServer       *bool       `json:"keep_on_server,omitempty"`
+}
+
+// ChannelEmailVerify is the request to verify and save an email channel.
+// Zammad sends a mail with the outbound account and waits until it is
+// fetched with the inbound account.
+type ChannelEmailVerify struct {
+	ChannelID int                    `json:"channel_id,omitempty"`
+	GroupID   int                    `json:"group_id"`
+	Inbound   ChannelEmailAccount    `json:"inbound"`
+	Outbound  ChannelEmailAccount    `json:"outbound"`
+	Meta      ChannelEmailVerifyMeta `json:"meta"`
+}
+
+type ChannelEmailVerifyMeta struct {
+	Email    string `json:"email"`
+	Realname string `json:"realname"`
+}
+
+// channelEmailResult is the response of the probes, result is ok if they
+// succeeded.
+type channelEmailResult struct {
+	Result          string                 `json:"result"`
+	Message         string                 `json:"message"`
+	MessageHuman    string                 `json:"message_human"`
+	InvalidField    map[string]interface{} `json:"invalid_field"`
+	ContentMessages int                    `json:"content_messages"`
+}
+
+func (r channelEmailResult) err() error {
+	if r.Result == "ok" {
+		return nil
+	}
+	message := r.MessageHuman
+	if message == "" {
+		message = r.Message
+	}
+	return fmt.Errorf("%s: %s", r.Result, message)
+}
+
+func (c *Client) postChannelEmail(path string, params interface{}) error {
+	rb, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", c.host+path, bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	body, err := c.doRequestTimeout(req, channelEmailTimeout)
+	if err != nil {
+		return err
+	}
+	result := channelEmailResult{Result: "ok"}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+	return result.err()
+}
+
+// ProbeEmailInbound checks that Zammad can log in with an inbound account.
+func (c *Client) ProbeEmailInbound(account ChannelEmailAccount) error {
+	return c.postChannelEmail("/api/v1/channels_email_inbound", account)
+}
+
+// ProbeEmailOutbound checks that Zammad can send mails with an outbound
+// account.
+func (c *Client) ProbeEmailOutbound(account ChannelEmailAccount, email string) error {
+	return c.postChannelEmail("/api/v1/channels_email_outbound", struct {
+		ChannelEmailAccount
+		Email string `json:"email"`
+	}{account, email})
+}
+
+// VerifyEmailChannel verifies the accounts and saves the channel. It creates
+// a new channel unless ChannelID is set.
+func (c *Client) VerifyEmailChannel(verify *ChannelEmailVerify) (*ChannelEmail, error) {
+	err := c.postChannelEmail("/api/v1/channels_email_verify", verify)
+	if err != nil {
+		return nil, err
+	}
+	if verify.ChannelID != 0 {
+		return c.GetEmailChannel(verify.ChannelID)
+	}
+
+	// The response does not contain the new channel, it is the newest one
+	// with the same inbound account.
+	channels, err := c.GetEmailChannels()
+	if err != nil {
+		return nil, err
+	}
+	var channel *ChannelEmail
+	for i, ch := range channels {
+		in := ch.Options.Inbound
+		if in.Adapter == verify.Inbound.Adapter && in.Options.Host == verify.Inbound.Options.Host && in.Options.User == verify.Inbound.Options.User && (channel == nil || ch.ID > channel.ID) {
+			channel = &channels[i]
+		}
+	}
+	if channel == nil {
+		return nil, fmt.Errorf("channel for %s not found after verification", verify.Meta.Email)
+	}
+	return channel, nil
+}
+
+// GetEmailChannels returns the email accounts, notification channels are
+// left out.
+func (c *Client) GetEmailChannels() ([]ChannelEmail, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/channels_email", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	index := struct {
+		AccountChannelIDs []int `json:"account_channel_ids"`
+		Assets            struct {
+			Channel map[string]ChannelEmail `json:"Channel"`
+		} `json:"assets"`
+	}{}
+	err = json.Unmarshal(body, &index)
+	if err != nil {
+		return nil, err
+	}
+	channels := make([]ChannelEmail, 0, len(index.AccountChannelIDs))
+	for _, id := range index.AccountChannelIDs {
+		if ch, ok := index.Assets.Channel[strconv.Itoa(id)]; ok {
+			channels = append(channels, ch)
+		}
+	}
+	return channels, nil
+}
+
+func (c *Client) GetEmailChannel(id int) (*ChannelEmail, error) {
+	channels, err := c.GetEmailChannels()
+	if err != nil {
+		return nil, err
+	}
+	for i := range channels {
+		if channels[i].ID == id {
+			return &channels[i], nil
+		}
+	}
+	return nil, fmt.Errorf("email channel %d not found", id)
+}
+
+// SetEmailChannelGroup sets the group of the tickets created from the mails
+// of a channel.
+func (c *Client) SetEmailChannelGroup(id, groupID int) error {
+	rb, err := json.Marshal(map[string]int{"group_id": groupID})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/channels_email_group/"+strconv.Itoa(id), bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) SetEmailChannelActive(id int, active bool) error {
+	action := "disable"
+	if active {
+		action = "enable"
+	}
+	rb, err := json.Marshal(map[string]int{"id": id})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/channels_email_"+action, bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) DeleteEmailChannel(ch *ChannelEmail) error {
+	rb, err := json.Marshal(map[string]int{"id": ch.ID})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/channels_email", bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
@@ -16,9 +16,12 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -38,9 +41,19 @@ func New(host, token string, transport http.RoundTripper) (*Client, error) {
 }
 
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	return c.doRequestTimeout(req, c.httpClient.Timeout)
+}
+
+// doRequestTimeout is doRequest for the few endpoints that take longer than
+// the default timeout.
+func (c *Client) doRequestTimeout(req *http.Request, timeout time.Duration) ([]byte, error) {
+	httpClient := *c.httpClient
+	httpClient.Timeout = timeout
 	req.Header.Set("Authorization", "Bearer "+c.token)
-	req.Header.Set("Content-Type", "application/json")
-	res, err := c.httpClient.Do(req)
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -54,3 +67,36 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	}
 	return body, err
 }
+
+// jsonFieldNames returns the JSON names of the fields of a struct type.
+func jsonFieldNames(t reflect.Type) map[string]struct{} {
+	names := map[string]struct{}{}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names[name] = struct{}{}
+		}
+	}
+	return names
+}
+
+// IDs is a list of IDs in settings and preferences, which the Zammad UI
+// stores as strings.
+type IDs []int
+
+func (ids *IDs) UnmarshalJSON(data []byte) error {
+	values := []json.Number{}
+	err := json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+	*ids = make(IDs, 0, len(values))
+	for _, v := range values {
+		id, err := strconv.Atoi(v.String())
+		if err != nil {
+			return err
+		}
+		*ids = append(*ids, id)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+// Condition is a single condition of a selector, as used by core workflows,
+// triggers and report profiles. The conditions are keyed by the attribute
+// they check, e.g. ticket.state_id.
+type Condition struct {
+	Operator     string      `json:"operator"`
+	Value        interface{} `json:"value,omitempty"`
+	Range        string      `json:"range,omitempty"`
+	PreCondition string      `json:"pre_condition,omitempty"`
+}
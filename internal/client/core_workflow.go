@@ -0,0 +1,109 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type CoreWorkflow struct {
+	ID                int                               `json:"id,omitempty"`
+	Name              string                            `json:"name"`
+	Object            string                            `json:"object"`
+	Preferences       map[string]interface{}            `json:"preferences"`
+	ConditionSaved    map[string]Condition              `json:"condition_saved"`
+	ConditionSelected map[string]Condition              `json:"condition_selected"`
+	Perform           map[string]map[string]interface{} `json:"perform"`
+	Priority          int                               `json:"priority,omitempty"`
+	StopAfterMatch    bool                              `json:"stop_after_match"`
+	Changeable        bool                              `json:"changeable"`
+	Active            bool                              `json:"active"`
+	CreatedAt         string                            `json:"created_at,omitempty"`
+	UpdatedAt         string                            `json:"updated_at,omitempty"`
+	CreatedByID       int                               `json:"created_by_id,omitempty"`
+	UpdatedByID       int                               `json:"updated_by_id,omitempty"`
+}
+
+func (c *Client) CreateCoreWorkflow(cw *CoreWorkflow) (*CoreWorkflow, error) {
+	rb, err := json.Marshal(cw)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/core_workflows", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newcw := &CoreWorkflow{}
+	err = json.Unmarshal(body, newcw)
+	if err != nil {
+		return nil, err
+	}
+	return newcw, nil
+}
+
+func (c *Client) GetCoreWorkflow(id int) (*CoreWorkflow, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/core_workflows/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newcw := &CoreWorkflow{}
+	err = json.Unmarshal(body, newcw)
+	if err != nil {
+		return nil, err
+	}
+	return newcw, nil
+}
+
+func (c *Client) UpdateCoreWorkflow(cw *CoreWorkflow) (*CoreWorkflow, error) {
+	rb, err := json.Marshal(cw)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/core_workflows/"+strconv.Itoa(cw.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newcw := &CoreWorkflow{}
+	err = json.Unmarshal(body, newcw)
+	if err != nil {
+		return nil, err
+	}
+	return newcw, nil
+}
+
+func (c *Client) DeleteCoreWorkflow(cw *CoreWorkflow) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/core_workflows/"+strconv.Itoa(cw.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type EmailAddress struct {
+	ID          int    `json:"id,omitempty"`
+	Realname    string `json:"realname"`
+	Email       string `json:"email"`
+	ChannelID   *int   `json:"channel_id"`
+	Active      bool   `json:"active"`
+	Note        string `json:"note"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	CreatedByID int    `json:"created_by_id,omitempty"`
+	UpdatedByID int    `json:"updated_by_id,omitempty"`
+}
+
+func (c *Client) CreateEmailAddress(ea *EmailAddress) (*EmailAddress, error) {
+	rb, err := json.Marshal(ea)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/email_addresses", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newea := &EmailAddress{}
+	err = json.Unmarshal(body, newea)
+	if err != nil {
+		return nil, err
+	}
+	return newea, nil
+}
+
+func (c *Client) GetEmailAddress(id int) (*EmailAddress, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/email_addresses/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newea := &EmailAddress{}
+	err = json.Unmarshal(body, newea)
+	if err != nil {
+		return nil, err
+	}
+	return newea, nil
+}
+
+func (c *Client) GetEmailAddresses() ([]EmailAddress, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/email_addresses", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	addresses := []EmailAddress{}
+	err = json.Unmarshal(body, &addresses)
+	if err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+func (c *Client) UpdateEmailAddress(ea *EmailAddress) (*EmailAddress, error) {
+	rb, err := json.Marshal(ea)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/email_addresses/"+strconv.Itoa(ea.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newea := &EmailAddress{}
+	err = json.Unmarshal(body, newea)
+	if err != nil {
+		return nil, err
+	}
+	return newea, nil
+}
+
+func (c *Client) DeleteEmailAddress(ea *EmailAddress) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/email_addresses/"+strconv.Itoa(ea.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type Group struct {
+	ID                 int    `json:"id,omitempty"`
+	Name               string `json:"name"`
+	EmailAddressID     *int   `json:"email_address_id"`
+	SignatureID        *int   `json:"signature_id"`
+	AssignmentTimeout  *int   `json:"assignment_timeout"`
+	FollowUpPossible   string `json:"follow_up_possible"`
+	FollowUpAssignment bool   `json:"follow_up_assignment"`
+	Note               string `json:"note"`
+	Active             bool   `json:"active"`
+	CreatedAt          string `json:"created_at,omitempty"`
+	UpdatedAt          string `json:"updated_at,omitempty"`
+}
+
+func (c *Client) GetGroups() ([]Group, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/groups", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	groups := []Group{}
+	err = json.Unmarshal(body, &groups)
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
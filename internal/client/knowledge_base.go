@@ -0,0 +1,194 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+type KnowledgeBase struct {
+	ID              int                        `json:"id,omitempty"`
+	IconSet         string                     `json:"iconset"`
+	ColorHighlight  string                     `json:"color_highlight"`
+	ColorHeader     string                     `json:"color_header"`
+	ColorHeaderLink string                     `json:"color_header_link"`
+	HomepageLayout  string                     `json:"homepage_layout"`
+	CategoryLayout  string                     `json:"category_layout"`
+	CustomAddress   *string                    `json:"custom_address"`
+	ShowFeedIcon    bool                       `json:"show_feed_icon"`
+	Active          bool                       `json:"active"`
+	Locales         []KnowledgeBaseLocale      `json:"kb_locales_attributes,omitempty"`
+	Translations    []KnowledgeBaseTranslation `json:"translations_attributes,omitempty"`
+	CreatedAt       string                     `json:"created_at,omitempty"`
+	UpdatedAt       string                     `json:"updated_at,omitempty"`
+}
+
+type KnowledgeBaseLocale struct {
+	ID              int  `json:"id,omitempty"`
+	KnowledgeBaseID int  `json:"knowledge_base_id,omitempty"`
+	SystemLocaleID  int  `json:"system_locale_id"`
+	Primary         bool `json:"primary"`
+	Destroy         bool `json:"_destroy,omitempty"`
+}
+
+type KnowledgeBaseTranslation struct {
+	ID              int    `json:"id,omitempty"`
+	KnowledgeBaseID int    `json:"knowledge_base_id,omitempty"`
+	KbLocaleID      int    `json:"kb_locale_id"`
+	Title           string `json:"title"`
+	FooterNote      string `json:"footer_note"`
+	Destroy         bool   `json:"_destroy,omitempty"`
+}
+
+// knowledgeBaseAssets is the response of the knowledge base endpoints with
+// full=true, the object and its associations keyed by class and ID.
+type knowledgeBaseAssets struct {
+	ID     int                                   `json:"id"`
+	Assets map[string]map[string]json.RawMessage `json:"assets"`
+}
+
+// decode decodes the assets of a class, sorted by ID, and passes them to fn.
+func (a *knowledgeBaseAssets) decode(class string, fn func(json.RawMessage) error) error {
+	ids := make([]int, 0, len(a.Assets[class]))
+	for id := range a.Assets[class] {
+		i, err := strconv.Atoi(id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, i)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		if err := fn(a.Assets[class][strconv.Itoa(id)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) getKnowledgeBaseAssets(path string) (*knowledgeBaseAssets, error) {
+	req, err := http.NewRequest("GET", c.host+path+"?full=true", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	assets := &knowledgeBaseAssets{}
+	err = json.Unmarshal(body, assets)
+	if err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+// sendKnowledgeBaseObject sends obj and returns the ID of the created or
+// updated object.
+func (c *Client) sendKnowledgeBaseObject(method, path string, obj interface{}) (int, error) {
+	rb, err := json.Marshal(obj)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest(method, c.host+path, bytes.NewReader(rb))
+	if err != nil {
+		return 0, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return 0, err
+	}
+	result := struct {
+		ID int `json:"id"`
+	}{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return 0, err
+	}
+	return result.ID, nil
+}
+
+func (c *Client) CreateKnowledgeBase(kb *KnowledgeBase) (*KnowledgeBase, error) {
+	id, err := c.sendKnowledgeBaseObject("POST", "/api/v1/knowledge_bases/manage", kb)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetKnowledgeBase(id)
+}
+
+// GetKnowledgeBase returns a knowledge base with its locales and
+// translations.
+func (c *Client) GetKnowledgeBase(id int) (*KnowledgeBase, error) {
+	assets, err := c.getKnowledgeBaseAssets("/api/v1/knowledge_bases/manage/" + strconv.Itoa(id))
+	if err != nil {
+		return nil, err
+	}
+	kb := &KnowledgeBase{}
+	err = json.Unmarshal(assets.Assets["KnowledgeBase"][strconv.Itoa(id)], kb)
+	if err != nil {
+		return nil, err
+	}
+	kb.Locales = nil
+	kb.Translations = nil
+	err = assets.decode("KnowledgeBaseLocale", func(raw json.RawMessage) error {
+		l := KnowledgeBaseLocale{}
+		if err := json.Unmarshal(raw, &l); err != nil {
+			return err
+		}
+		if l.KnowledgeBaseID == id {
+			kb.Locales = append(kb.Locales, l)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = assets.decode("KnowledgeBaseTranslation", func(raw json.RawMessage) error {
+		t := KnowledgeBaseTranslation{}
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return err
+		}
+		if t.KnowledgeBaseID == id {
+			kb.Translations = append(kb.Translations, t)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return kb, nil
+}
+
+func (c *Client) UpdateKnowledgeBase(kb *KnowledgeBase) (*KnowledgeBase, error) {
+	_, err := c.sendKnowledgeBaseObject("PATCH", "/api/v1/knowledge_bases/manage/"+strconv.Itoa(kb.ID), kb)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetKnowledgeBase(kb.ID)
+}
+
+func (c *Client) DeleteKnowledgeBase(kb *KnowledgeBase) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/knowledge_bases/manage/"+strconv.Itoa(kb.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,206 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+)
+
+type KnowledgeBaseAnswer struct {
+	ID              int                              `json:"id,omitempty"`
+	KnowledgeBaseID int                              `json:"-"`
+	CategoryID      int                              `json:"category_id"`
+	Position        int                              `json:"position,omitempty"`
+	InternalAt      *string                          `json:"internal_at,omitempty"`
+	PublishedAt     *string                          `json:"published_at,omitempty"`
+	ArchivedAt      *string                          `json:"archived_at,omitempty"`
+	Translations    []KnowledgeBaseAnswerTranslation `json:"translations_attributes,omitempty"`
+	Attachments     []KnowledgeBaseAnswerAttachment  `json:"-"`
+	CreatedAt       string                           `json:"created_at,omitempty"`
+	UpdatedAt       string                           `json:"updated_at,omitempty"`
+}
+
+type KnowledgeBaseAnswerTranslation struct {
+	ID         int                                    `json:"id,omitempty"`
+	AnswerID   int                                    `json:"answer_id,omitempty"`
+	KbLocaleID int                                    `json:"kb_locale_id"`
+	Title      string                                 `json:"title"`
+	ContentID  int                                    `json:"content_id,omitempty"`
+	Content    *KnowledgeBaseAnswerTranslationContent `json:"content_attributes,omitempty"`
+	Destroy    bool                                   `json:"_destroy,omitempty"`
+}
+
+type KnowledgeBaseAnswerTranslationContent struct {
+	ID   int    `json:"id,omitempty"`
+	Body string `json:"body"`
+}
+
+type KnowledgeBaseAnswerAttachment struct {
+	ID       int    `json:"id"`
+	Filename string `json:"filename"`
+	Size     string `json:"size"`
+}
+
+func knowledgeBaseAnswerPath(kbID int) string {
+	return "/api/v1/knowledge_bases/" + strconv.Itoa(kbID) + "/answers"
+}
+
+func (c *Client) CreateKnowledgeBaseAnswer(answer *KnowledgeBaseAnswer) (*KnowledgeBaseAnswer, error) {
+	id, err := c.sendKnowledgeBaseObject("POST", knowledgeBaseAnswerPath(answer.KnowledgeBaseID), answer)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetKnowledgeBaseAnswer(answer.KnowledgeBaseID, id)
+}
+
+// GetKnowledgeBaseAnswer returns an answer with its translations and their
+// content.
+func (c *Client) GetKnowledgeBaseAnswer(kbID, id int) (*KnowledgeBaseAnswer, error) {
+	assets, err := c.getKnowledgeBaseAssets(knowledgeBaseAnswerPath(kbID) + "/" + strconv.Itoa(id))
+	if err != nil {
+		return nil, err
+	}
+	answer := &KnowledgeBaseAnswer{}
+	err = json.Unmarshal(assets.Assets["KnowledgeBaseAnswer"][strconv.Itoa(id)], answer)
+	if err != nil {
+		return nil, err
+	}
+	answer.KnowledgeBaseID = kbID
+	answer.Translations = nil
+	// Attachments are only part of the responses, they are managed with
+	// their own endpoints.
+	attachments := struct {
+		Attachments []KnowledgeBaseAnswerAttachment `json:"attachments"`
+	}{}
+	err = json.Unmarshal(assets.Assets["KnowledgeBaseAnswer"][strconv.Itoa(id)], &attachments)
+	if err != nil {
+		return nil, err
+	}
+	answer.Attachments = attachments.Attachments
+	err = assets.decode("KnowledgeBaseAnswerTranslation", func(raw json.RawMessage) error {
+		t := KnowledgeBaseAnswerTranslation{}
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return err
+		}
+		if t.AnswerID != id {
+			return nil
+		}
+		if content, ok := assets.Assets["KnowledgeBaseAnswerTranslationContent"][strconv.Itoa(t.ContentID)]; ok {
+			t.Content = &KnowledgeBaseAnswerTranslationContent{}
+			if err := json.Unmarshal(content, t.Content); err != nil {
+				return err
+			}
+		}
+		answer.Translations = append(answer.Translations, t)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
+
+func (c *Client) UpdateKnowledgeBaseAnswer(answer *KnowledgeBaseAnswer) (*KnowledgeBaseAnswer, error) {
+	_, err := c.sendKnowledgeBaseObject("PATCH", knowledgeBaseAnswerPath(answer.KnowledgeBaseID)+"/"+strconv.Itoa(answer.ID), answer)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetKnowledgeBaseAnswer(answer.KnowledgeBaseID, answer.ID)
+}
+
+// PublishKnowledgeBaseAnswer changes the publishing state of an answer. The
+// command is one of internal, publish, archive or unarchive. For internal and
+// publish, date schedules the change, it is applied immediately if empty.
+func (c *Client) PublishKnowledgeBaseAnswer(kbID, id int, command, date string) error {
+	params := map[string]string{}
+	if date != "" {
+		params["date"] = date
+	}
+	rb, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", c.host+knowledgeBaseAnswerPath(kbID)+"/"+strconv.Itoa(id)+"/"+command, bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
+
+// AddKnowledgeBaseAnswerAttachment uploads a file to an answer and returns the
+// new attachment.
+func (c *Client) AddKnowledgeBaseAnswerAttachment(answer *KnowledgeBaseAnswer, filename string, content []byte) (*KnowledgeBaseAnswerAttachment, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+knowledgeBaseAnswerPath(answer.KnowledgeBaseID)+"/"+strconv.Itoa(answer.ID)+"/attachments", &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := c.GetKnowledgeBaseAnswer(answer.KnowledgeBaseID, answer.ID)
+	if err != nil {
+		return nil, err
+	}
+	var attachment *KnowledgeBaseAnswerAttachment
+	for i, a := range updated.Attachments {
+		if a.Filename == filename && (attachment == nil || a.ID > attachment.ID) {
+			attachment = &updated.Attachments[i]
+		}
+	}
+	if attachment == nil {
+		return nil, fmt.Errorf("attachment %s not found after upload", filename)
+	}
+	return attachment, nil
+}
+
+func (c *Client) DeleteKnowledgeBaseAnswerAttachment(answer *KnowledgeBaseAnswer, id int) error {
+	req, err := http.NewRequest("DELETE", c.host+knowledgeBaseAnswerPath(answer.KnowledgeBaseID)+"/"+strconv.Itoa(answer.ID)+"/attachments/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) DeleteKnowledgeBaseAnswer(answer *KnowledgeBaseAnswer) error {
+	req, err := http.NewRequest("DELETE", c.host+knowledgeBaseAnswerPath(answer.KnowledgeBaseID)+"/"+strconv.Itoa(answer.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type KnowledgeBaseCategory struct {
+	ID              int                                `json:"id,omitempty"`
+	KnowledgeBaseID int                                `json:"knowledge_base_id"`
+	ParentID        *int                               `json:"parent_id"`
+	CategoryIcon    string                             `json:"category_icon"`
+	Position        int                                `json:"position,omitempty"`
+	Translations    []KnowledgeBaseCategoryTranslation `json:"translations_attributes,omitempty"`
+	CreatedAt       string                             `json:"created_at,omitempty"`
+	UpdatedAt       string                             `json:"updated_at,omitempty"`
+}
+
+type KnowledgeBaseCategoryTranslation struct {
+	ID         int    `json:"id,omitempty"`
+	CategoryID int    `json:"category_id,omitempty"`
+	KbLocaleID int    `json:"kb_locale_id"`
+	Title      string `json:"title"`
+	Destroy    bool   `json:"_destroy,omitempty"`
+}
+
+func knowledgeBaseCategoryPath(kbID int) string {
+	return "/api/v1/knowledge_bases/" + strconv.Itoa(kbID) + "/categories"
+}
+
+func (c *Client) CreateKnowledgeBaseCategory(cat *KnowledgeBaseCategory) (*KnowledgeBaseCategory, error) {
+	id, err := c.sendKnowledgeBaseObject("POST", knowledgeBaseCategoryPath(cat.KnowledgeBaseID), cat)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetKnowledgeBaseCategory(cat.KnowledgeBaseID, id)
+}
+
+// GetKnowledgeBaseCategory returns a category with its translations.
+func (c *Client) GetKnowledgeBaseCategory(kbID, id int) (*KnowledgeBaseCategory, error) {
+	assets, err := c.getKnowledgeBaseAssets(knowledgeBaseCategoryPath(kbID) + "/" + strconv.Itoa(id))
+	if err != nil {
+		return nil, err
+	}
+	cat := &KnowledgeBaseCategory{}
+	err = json.Unmarshal(assets.Assets["KnowledgeBaseCategory"][strconv.Itoa(id)], cat)
+	if err != nil {
+		return nil, err
+	}
+	cat.Translations = nil
+	err = assets.decode("KnowledgeBaseCategoryTranslation", func(raw json.RawMessage) error {
+		t := KnowledgeBaseCategoryTranslation{}
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return err
+		}
+		if t.CategoryID == id {
+			cat.Translations = append(cat.Translations, t)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cat, nil
+}
+
+func (c *Client) UpdateKnowledgeBaseCategory(cat *KnowledgeBaseCategory) (*KnowledgeBaseCategory, error) {
+	_, err := c.sendKnowledgeBaseObject("PATCH", knowledgeBaseCategoryPath(cat.KnowledgeBaseID)+"/"+strconv.Itoa(cat.ID), cat)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetKnowledgeBaseCategory(cat.KnowledgeBaseID, cat.ID)
+}
+
+func (c *Client) DeleteKnowledgeBaseCategory(cat *KnowledgeBaseCategory) error {
+	req, err := http.NewRequest("DELETE", c.host+knowledgeBaseCategoryPath(cat.KnowledgeBaseID)+"/"+strconv.Itoa(cat.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type LdapSource struct {
+	ID          int                   `json:"id,omitempty"`
+	Name        string                `json:"name"`
+	Preferences LdapSourcePreferences `json:"preferences"`
+	Active      bool                  `json:"active"`
+	CreatedAt   string                `json:"created_at,omitempty"`
+	UpdatedAt   string                `json:"updated_at,omitempty"`
+	CreatedByID int                   `json:"created_by_id,omitempty"`
+	UpdatedByID int                   `json:"updated_by_id,omitempty"`
+}
+
+type LdapSourcePreferences struct {
+	Host            string            `json:"host"`
+	SSL             string            `json:"ssl,omitempty"`
+	SSLVerify       *bool             `json:"ssl_verify,omitempty"`
+	BindUser        string            `json:"bind_user,omitempty"`
+	BindPw          string            `json:"bind_pw,omitempty"`
+	BaseDN          string            `json:"base_dn"`
+	UserFilter      string            `json:"user_filter,omitempty"`
+	UserUID         string            `json:"user_uid,omitempty"`
+	UserAttributes  map[string]string `json:"user_attributes,omitempty"`
+	GroupFilter     string            `json:"group_filter,omitempty"`
+	GroupRoleMap    map[string]IDs    `json:"group_role_map,omitempty"`
+	UnassignedUsers string            `json:"unassigned_users,omitempty"`
+}
+
+func (c *Client) CreateLdapSource(ls *LdapSource) (*LdapSource, error) {
+	rb, err := json.Marshal(ls)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/ldap_sources", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newls := &LdapSource{}
+	err = json.Unmarshal(body, newls)
+	if err != nil {
+		return nil, err
+	}
+	return newls, nil
+}
+
+func (c *Client) GetLdapSource(id int) (*LdapSource, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/ldap_sources/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newls := &LdapSource{}
+	err = json.Unmarshal(body, newls)
+	if err != nil {
+		return nil, err
+	}
+	return newls, nil
+}
+
+func (c *Client) UpdateLdapSource(ls *LdapSource) (*LdapSource, error) {
+	rb, err := json.Marshal(ls)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/ldap_sources/"+strconv.Itoa(ls.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newls := &LdapSource{}
+	err = json.Unmarshal(body, newls)
+	if err != nil {
+		return nil, err
+	}
+	return newls, nil
+}
+
+func (c *Client) DeleteLdapSource(ls *LdapSource) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/ldap_sources/"+strconv.Itoa(ls.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
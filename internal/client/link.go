@@ -0,0 +1,82 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Link struct {
+	LinkType               string `json:"link_type"`
+	LinkObjectSource       string `json:"link_object_source"`
+	LinkObjectSourceNumber string `json:"link_object_source_number,omitempty"`
+	LinkObjectSourceValue  int    `json:"link_object_source_value,omitempty"`
+	LinkObjectTarget       string `json:"link_object_target"`
+	LinkObjectTargetValue  int    `json:"link_object_target_value"`
+}
+
+// LinkItem is a link of an object as listed by Zammad, pointing to the
+// linked object.
+type LinkItem struct {
+	LinkType        string `json:"link_type"`
+	LinkObject      string `json:"link_object"`
+	LinkObjectValue int    `json:"link_object_value"`
+}
+
+func (c *Client) AddLink(link *Link) error {
+	return c.linkRequest("POST", "/api/v1/links/add", link)
+}
+
+func (c *Client) RemoveLink(link *Link) error {
+	return c.linkRequest("DELETE", "/api/v1/links/remove", link)
+}
+
+func (c *Client) linkRequest(method, path string, link *Link) error {
+	rb, err := json.Marshal(link)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(method, c.host+path, bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
+
+// GetLinks returns the links of an object, e.g. a Ticket.
+func (c *Client) GetLinks(object string, id int) ([]LinkItem, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/links?link_object="+url.QueryEscape(object)+"&link_object_value="+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	links := struct {
+		Links []LinkItem `json:"links"`
+	}{}
+	err = json.Unmarshal(body, &links)
+	if err != nil {
+		return nil, err
+	}
+	return links.Links, nil
+}
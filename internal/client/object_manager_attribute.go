@@ -0,0 +1,142 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type ObjectManagerAttributeScreen struct {
+	Shown    bool `json:"shown"`
+	Required bool `json:"required"`
+}
+
+type ObjectManagerAttribute struct {
+	ID          int                                                `json:"id,omitempty"`
+	Object      string                                             `json:"object,omitempty"`
+	Name        string                                             `json:"name"`
+	Display     string                                             `json:"display"`
+	DataType    string                                             `json:"data_type"`
+	DataOption  map[string]interface{}                             `json:"data_option"`
+	Screens     map[string]map[string]ObjectManagerAttributeScreen `json:"screens"`
+	Position    *int                                               `json:"position,omitempty"`
+	Active      bool                                               `json:"active"`
+	Editable    bool                                               `json:"editable,omitempty"`
+	CreatedAt   string                                             `json:"created_at,omitempty"`
+	UpdatedAt   string                                             `json:"updated_at,omitempty"`
+	CreatedByID int                                                `json:"created_by_id,omitempty"`
+	UpdatedByID int                                                `json:"updated_by_id,omitempty"`
+}
+
+func (c *Client) CreateObjectManagerAttribute(attr *ObjectManagerAttribute) (*ObjectManagerAttribute, error) {
+	rb, err := json.Marshal(attr)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/object_manager_attributes", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newattr := &ObjectManagerAttribute{}
+	err = json.Unmarshal(body, newattr)
+	if err != nil {
+		return nil, err
+	}
+	return newattr, nil
+}
+
+func (c *Client) GetObjectManagerAttributes() ([]ObjectManagerAttribute, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/object_manager_attributes", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	attrs := []ObjectManagerAttribute{}
+	err = json.Unmarshal(body, &attrs)
+	if err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+func (c *Client) GetObjectManagerAttribute(id int) (*ObjectManagerAttribute, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/object_manager_attributes/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newattr := &ObjectManagerAttribute{}
+	err = json.Unmarshal(body, newattr)
+	if err != nil {
+		return nil, err
+	}
+	return newattr, nil
+}
+
+func (c *Client) UpdateObjectManagerAttribute(attr *ObjectManagerAttribute) (*ObjectManagerAttribute, error) {
+	rb, err := json.Marshal(attr)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/object_manager_attributes/"+strconv.Itoa(attr.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newattr := &ObjectManagerAttribute{}
+	err = json.Unmarshal(body, newattr)
+	if err != nil {
+		return nil, err
+	}
+	return newattr, nil
+}
+
+func (c *Client) DeleteObjectManagerAttribute(attr *ObjectManagerAttribute) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/object_manager_attributes/"+strconv.Itoa(attr.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
+
+// ExecuteObjectManagerAttributeMigrations applies the pending changes of the
+// object manager attributes to the database, attributes can only be used once
+// they are migrated.
+func (c *Client) ExecuteObjectManagerAttributeMigrations() error {
+	req, err := http.NewRequest("POST", c.host+"/api/v1/object_manager_attributes_execute_migrations", nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"net/http"
+	"reflect"
 	"strconv"
 )
 
@@ -35,6 +36,52 @@ type Organization struct {
 	UpdatedAt        string `json:"updated_at,omitempty"`
 	CreatedByID      int    `json:"created_by_id,omitempty"`
 	UpdatedByID      int    `json:"updated_by_id,omitempty"`
+
+	// Extra holds the fields which are not part of the struct, such as the
+	// values of custom object manager attributes.
+	Extra map[string]interface{} `json:"-"`
+}
+
+// organizationFields are the JSON names of the fields of Organization.
+var organizationFields = jsonFieldNames(reflect.TypeOf(Organization{}))
+
+func (o Organization) MarshalJSON() ([]byte, error) {
+	type organization Organization
+	rb, err := json.Marshal(organization(o))
+	if err != nil || len(o.Extra) == 0 {
+		return rb, err
+	}
+	fields := map[string]interface{}{}
+	err = json.Unmarshal(rb, &fields)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range o.Extra {
+		if _, ok := organizationFields[k]; !ok {
+			fields[k] = v
+		}
+	}
+	return json.Marshal(fields)
+}
+
+func (o *Organization) UnmarshalJSON(data []byte) error {
+	type organization Organization
+	err := json.Unmarshal(data, (*organization)(o))
+	if err != nil {
+		return err
+	}
+	fields := map[string]interface{}{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	o.Extra = map[string]interface{}{}
+	for k, v := range fields {
+		if _, ok := organizationFields[k]; !ok {
+			o.Extra[k] = v
+		}
+	}
+	return nil
 }
 
 func (c *Client) CreateOrganization(org *Organization) (*Organization, error) {
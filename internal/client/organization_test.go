@@ -0,0 +1,54 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationExtraFields(t *testing.T) {
+	org := &Organization{}
+	err := json.Unmarshal([]byte(`{"id":3,"name":"Example","active":true,"customer_tier":"gold","contract_id":42,"vip":null}`), org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if org.ID != 3 || org.Name != "Example" || !org.Active {
+		t.Errorf("built-in fields not decoded: %+v", org)
+	}
+	wantExtra := map[string]interface{}{"customer_tier": "gold", "contract_id": float64(42), "vip": nil}
+	if !reflect.DeepEqual(org.Extra, wantExtra) {
+		t.Errorf("Extra = %v, want %v", org.Extra, wantExtra)
+	}
+
+	org = &Organization{Name: "Example", Extra: map[string]interface{}{"customer_tier": "silver", "name": "ignored", "contract_id": nil}}
+	rb, err := json.Marshal(org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := map[string]interface{}{}
+	err = json.Unmarshal(rb, &fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields["name"] != "Example" || fields["customer_tier"] != "silver" {
+		t.Errorf("unexpected encoding %s", rb)
+	}
+	if v, ok := fields["contract_id"]; !ok || v != nil {
+		t.Errorf("contract_id should be encoded as null: %s", rb)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type PGPKey struct {
+	ID             int      `json:"id,omitempty"`
+	Key            string   `json:"key,omitempty"`
+	Passphrase     string   `json:"passphrase,omitempty"`
+	Name           string   `json:"name,omitempty"`
+	Fingerprint    string   `json:"fingerprint,omitempty"`
+	EmailAddresses []string `json:"email_addresses,omitempty"`
+	ExpiresAt      *string  `json:"expires_at,omitempty"`
+	Secret         bool     `json:"secret,omitempty"`
+	DomainAlias    string   `json:"domain_alias,omitempty"`
+	CreatedAt      string   `json:"created_at,omitempty"`
+	UpdatedAt      string   `json:"updated_at,omitempty"`
+}
+
+func (c *Client) CreatePGPKey(key *PGPKey) (*PGPKey, error) {
+	rb, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/integration/pgp/key", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newkey := &PGPKey{}
+	err = json.Unmarshal(body, newkey)
+	if err != nil {
+		return nil, err
+	}
+	return newkey, nil
+}
+
+func (c *Client) GetPGPKeys() ([]PGPKey, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/integration/pgp/key", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	keys := []PGPKey{}
+	err = json.Unmarshal(body, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (c *Client) GetPGPKey(id int) (*PGPKey, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/integration/pgp/key/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newkey := &PGPKey{}
+	err = json.Unmarshal(body, newkey)
+	if err != nil {
+		return nil, err
+	}
+	return newkey, nil
+}
+
+func (c *Client) DeletePGPKey(key *PGPKey) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/integration/pgp/key/"+strconv.Itoa(key.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
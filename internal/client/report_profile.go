@@ -0,0 +1,102 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type ReportProfile struct {
+	ID          int                  `json:"id,omitempty"`
+	Name        string               `json:"name"`
+	Condition   map[string]Condition `json:"condition"`
+	Active      bool                 `json:"active"`
+	CreatedAt   string               `json:"created_at,omitempty"`
+	UpdatedAt   string               `json:"updated_at,omitempty"`
+	CreatedByID int                  `json:"created_by_id,omitempty"`
+	UpdatedByID int                  `json:"updated_by_id,omitempty"`
+}
+
+func (c *Client) CreateReportProfile(rp *ReportProfile) (*ReportProfile, error) {
+	rb, err := json.Marshal(rp)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/report_profiles", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newrp := &ReportProfile{}
+	err = json.Unmarshal(body, newrp)
+	if err != nil {
+		return nil, err
+	}
+	return newrp, nil
+}
+
+func (c *Client) GetReportProfile(id int) (*ReportProfile, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/report_profiles/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newrp := &ReportProfile{}
+	err = json.Unmarshal(body, newrp)
+	if err != nil {
+		return nil, err
+	}
+	return newrp, nil
+}
+
+func (c *Client) UpdateReportProfile(rp *ReportProfile) (*ReportProfile, error) {
+	rb, err := json.Marshal(rp)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/report_profiles/"+strconv.Itoa(rp.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newrp := &ReportProfile{}
+	err = json.Unmarshal(body, newrp)
+	if err != nil {
+		return nil, err
+	}
+	return newrp, nil
+}
+
+func (c *Client) DeleteReportProfile(rp *ReportProfile) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/report_profiles/"+strconv.Itoa(rp.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type Role struct {
+	ID              int    `json:"id,omitempty"`
+	Name            string `json:"name"`
+	PermissionIDs   []int  `json:"permission_ids"`
+	DefaultAtSignup bool   `json:"default_at_signup"`
+	Note            string `json:"note"`
+	Active          bool   `json:"active"`
+	CreatedAt       string `json:"created_at,omitempty"`
+	UpdatedAt       string `json:"updated_at,omitempty"`
+}
+
+func (c *Client) GetRoles() ([]Role, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/roles", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	roles := []Role{}
+	err = json.Unmarshal(body, &roles)
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
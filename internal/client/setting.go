@@ -0,0 +1,118 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type Setting struct {
+	ID           int          `json:"id,omitempty"`
+	Name         string       `json:"name"`
+	Title        string       `json:"title,omitempty"`
+	Description  string       `json:"description,omitempty"`
+	Area         string       `json:"area,omitempty"`
+	StateCurrent SettingState `json:"state_current"`
+	CreatedAt    string       `json:"created_at,omitempty"`
+	UpdatedAt    string       `json:"updated_at,omitempty"`
+}
+
+// SettingState holds the value of a setting, which can be any JSON value.
+type SettingState struct {
+	Value json.RawMessage `json:"value"`
+}
+
+func (c *Client) GetSettings() ([]Setting, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/settings", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	settings := []Setting{}
+	err = json.Unmarshal(body, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// GetSettingByName returns the setting with the given name. Zammad only
+// addresses settings by ID, so all settings are fetched.
+func (c *Client) GetSettingByName(name string) (*Setting, error) {
+	settings, err := c.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	for i := range settings {
+		if settings[i].Name == name {
+			return &settings[i], nil
+		}
+	}
+	return nil, fmt.Errorf("setting %q not found", name)
+}
+
+// GetSettingsByName returns the settings with the given names, keyed by name.
+func (c *Client) GetSettingsByName(names ...string) (map[string]*Setting, error) {
+	settings, err := c.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*Setting, len(names))
+	for i := range settings {
+		for _, name := range names {
+			if settings[i].Name == name {
+				result[name] = &settings[i]
+			}
+		}
+	}
+	for _, name := range names {
+		if _, ok := result[name]; !ok {
+			return nil, fmt.Errorf("setting %q not found", name)
+		}
+	}
+	return result, nil
+}
+
+func (c *Client) UpdateSetting(id int, value json.RawMessage) (*Setting, error) {
+	if len(value) == 0 {
+		value = json.RawMessage("null")
+	}
+	rb, err := json.Marshal(map[string]SettingState{"state_current": {Value: value}})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/settings/"+strconv.Itoa(id), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newsetting := &Setting{}
+	err = json.Unmarshal(body, newsetting)
+	if err != nil {
+		return nil, err
+	}
+	return newsetting, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type SMIMECertificate struct {
+	ID             int      `json:"id"`
+	Fingerprint    string   `json:"fingerprint"`
+	Subject        string   `json:"subject"`
+	EmailAddresses []string `json:"email_addresses"`
+	NotBeforeAt    string   `json:"not_before_at"`
+	NotAfterAt     string   `json:"not_after_at"`
+	// PrivateKey is only used to tell whether the certificate has a private
+	// key, it is never read into the state.
+	PrivateKey string `json:"private_key"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+func (c *Client) GetSMIMECertificates() ([]SMIMECertificate, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/integration/smime/certificate", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	certs := []SMIMECertificate{}
+	err = json.Unmarshal(body, &certs)
+	if err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+// AddSMIMECertificate uploads PEM encoded certificates. Zammad stores every
+// certificate of a chain on its own, they are found by fingerprint
+// afterwards.
+func (c *Client) AddSMIMECertificate(pem string) error {
+	rb, err := json.Marshal(map[string]string{"data": pem})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/integration/smime/certificate", bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
+
+// AddSMIMEPrivateKey uploads a PEM encoded private key, which is stored with
+// the certificate it belongs to.
+func (c *Client) AddSMIMEPrivateKey(pem, secret string) error {
+	rb, err := json.Marshal(map[string]string{"data": pem, "secret": secret})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/integration/smime/private_key", bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) DeleteSMIMECertificate(cert *SMIMECertificate) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/integration/smime/certificate?id="+strconv.Itoa(cert.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
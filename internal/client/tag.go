@@ -0,0 +1,167 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Tag struct {
+	ID    int    `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Count int    `json:"count,omitempty"`
+}
+
+func (c *Client) GetTags() ([]Tag, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/tag_list", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	tags := []Tag{}
+	err = json.Unmarshal(body, &tags)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// GetTag returns the tag with the given ID. The tag admin API has no endpoint
+// for a single tag, so all tags are fetched.
+func (c *Client) GetTag(id int) (*Tag, error) {
+	tags, err := c.GetTags()
+	if err != nil {
+		return nil, err
+	}
+	for i := range tags {
+		if tags[i].ID == id {
+			return &tags[i], nil
+		}
+	}
+	return nil, fmt.Errorf("tag %d not found", id)
+}
+
+func (c *Client) getTagByName(name string) (*Tag, error) {
+	tags, err := c.GetTags()
+	if err != nil {
+		return nil, err
+	}
+	for i := range tags {
+		if tags[i].Name == name {
+			return &tags[i], nil
+		}
+	}
+	return nil, fmt.Errorf("tag %q not found", name)
+}
+
+// CreateTag creates a tag. Zammad does not return the new tag, it is looked up
+// by name afterwards.
+func (c *Client) CreateTag(tag *Tag) (*Tag, error) {
+	rb, err := json.Marshal(tag)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/tag_list", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return c.getTagByName(tag.Name)
+}
+
+// UpdateTag renames a tag, on all tickets it is used on.
+func (c *Client) UpdateTag(tag *Tag) (*Tag, error) {
+	rb, err := json.Marshal(tag)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/tag_list/"+strconv.Itoa(tag.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetTag(tag.ID)
+}
+
+func (c *Client) DeleteTag(tag *Tag) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/tag_list/"+strconv.Itoa(tag.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
+
+type objectTag struct {
+	Object string `json:"object"`
+	OID    int    `json:"o_id"`
+	Item   string `json:"item"`
+}
+
+// GetObjectTags returns the tags of an object, e.g. a Ticket.
+func (c *Client) GetObjectTags(object string, id int) ([]string, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/tags?object="+url.QueryEscape(object)+"&o_id="+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	tags := struct {
+		Tags []string `json:"tags"`
+	}{}
+	err = json.Unmarshal(body, &tags)
+	if err != nil {
+		return nil, err
+	}
+	return tags.Tags, nil
+}
+
+func (c *Client) AddObjectTag(object string, id int, tag string) error {
+	return c.objectTagRequest("POST", "/api/v1/tags/add", object, id, tag)
+}
+
+func (c *Client) RemoveObjectTag(object string, id int, tag string) error {
+	return c.objectTagRequest("DELETE", "/api/v1/tags/remove", object, id, tag)
+}
+
+func (c *Client) objectTagRequest(method, path, object string, id int, tag string) error {
+	rb, err := json.Marshal(objectTag{Object: object, OID: id, Item: tag})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(method, c.host+path, bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Ticket struct {
+	ID             int            `json:"id,omitempty"`
+	Number         string         `json:"number,omitempty"`
+	Title          string         `json:"title"`
+	GroupID        int            `json:"group_id"`
+	CustomerID     int            `json:"customer_id"`
+	OrganizationID *int           `json:"organization_id,omitempty"`
+	StateID        int            `json:"state_id,omitempty"`
+	State          string         `json:"state,omitempty"`
+	PriorityID     int            `json:"priority_id,omitempty"`
+	Priority       string         `json:"priority,omitempty"`
+	OwnerID        int            `json:"owner_id,omitempty"`
+	Tags           string         `json:"tags,omitempty"`
+	Article        *TicketArticle `json:"article,omitempty"`
+	CreatedAt      string         `json:"created_at,omitempty"`
+	UpdatedAt      string         `json:"updated_at,omitempty"`
+	CreatedByID    int            `json:"created_by_id,omitempty"`
+	UpdatedByID    int            `json:"updated_by_id,omitempty"`
+}
+
+func (c *Client) CreateTicket(t *Ticket) (*Ticket, error) {
+	rb, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/tickets", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newt := &Ticket{}
+	err = json.Unmarshal(body, newt)
+	if err != nil {
+		return nil, err
+	}
+	return newt, nil
+}
+
+// SearchTickets runs a ticket search. State and Priority are set on the
+// returned tickets. sortBy and orderBy are optional.
+func (c *Client) SearchTickets(query, sortBy, orderBy string, limit int) ([]Ticket, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("limit", strconv.Itoa(limit))
+	params.Set("expand", "true")
+	if sortBy != "" {
+		params.Set("sort_by", sortBy)
+	}
+	if orderBy != "" {
+		params.Set("order_by", orderBy)
+	}
+	req, err := http.NewRequest("GET", c.host+"/api/v1/tickets/search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	tickets := []Ticket{}
+	err = json.Unmarshal(body, &tickets)
+	if err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+func (c *Client) GetTicket(id int) (*Ticket, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/tickets/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newt := &Ticket{}
+	err = json.Unmarshal(body, newt)
+	if err != nil {
+		return nil, err
+	}
+	return newt, nil
+}
+
+func (c *Client) UpdateTicket(t *Ticket) (*Ticket, error) {
+	rb, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/tickets/"+strconv.Itoa(t.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newt := &Ticket{}
+	err = json.Unmarshal(body, newt)
+	if err != nil {
+		return nil, err
+	}
+	return newt, nil
+}
+
+func (c *Client) DeleteTicket(t *Ticket) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/tickets/"+strconv.Itoa(t.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
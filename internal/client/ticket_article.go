@@ -0,0 +1,58 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type TicketArticle struct {
+	ID          int    `json:"id,omitempty"`
+	TicketID    int    `json:"ticket_id,omitempty"`
+	Subject     string `json:"subject"`
+	Body        string `json:"body"`
+	ContentType string `json:"content_type,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Sender      string `json:"sender,omitempty"`
+	Internal    bool   `json:"internal"`
+	From        string `json:"from,omitempty"`
+	To          string `json:"to,omitempty"`
+	Cc          string `json:"cc,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	CreatedByID int    `json:"created_by_id,omitempty"`
+	UpdatedByID int    `json:"updated_by_id,omitempty"`
+}
+
+// GetTicketArticles returns the articles of a ticket, oldest first.
+func (c *Client) GetTicketArticles(ticketID int) ([]TicketArticle, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/ticket_articles/by_ticket/"+strconv.Itoa(ticketID)+"?expand=true", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	articles := []TicketArticle{}
+	err = json.Unmarshal(body, &articles)
+	if err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
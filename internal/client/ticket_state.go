@@ -0,0 +1,54 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type TicketState struct {
+	ID               int    `json:"id,omitempty"`
+	Name             string `json:"name"`
+	StateTypeID      int    `json:"state_type_id"`
+	NextStateID      *int   `json:"next_state_id"`
+	IgnoreEscalation bool   `json:"ignore_escalation"`
+	DefaultCreate    bool   `json:"default_create"`
+	DefaultFollowUp  bool   `json:"default_follow_up"`
+	Note             string `json:"note"`
+	Active           bool   `json:"active"`
+	CreatedAt        string `json:"created_at,omitempty"`
+	UpdatedAt        string `json:"updated_at,omitempty"`
+	CreatedByID      int    `json:"created_by_id,omitempty"`
+	UpdatedByID      int    `json:"updated_by_id,omitempty"`
+}
+
+func (c *Client) GetTicketStates() ([]TicketState, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/ticket_states", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	states := []TicketState{}
+	err = json.Unmarshal(body, &states)
+	if err != nil {
+		return nil, err
+	}
+	return states, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type TicketTimeAccountingType struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Note        string `json:"note"`
+	Active      bool   `json:"active"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	CreatedByID int    `json:"created_by_id,omitempty"`
+	UpdatedByID int    `json:"updated_by_id,omitempty"`
+}
+
+func (c *Client) CreateTicketTimeAccountingType(tt *TicketTimeAccountingType) (*TicketTimeAccountingType, error) {
+	rb, err := json.Marshal(tt)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/ticket_time_accounting_types", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newtype := &TicketTimeAccountingType{}
+	err = json.Unmarshal(body, newtype)
+	if err != nil {
+		return nil, err
+	}
+	return newtype, nil
+}
+
+func (c *Client) GetTicketTimeAccountingType(id int) (*TicketTimeAccountingType, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/ticket_time_accounting_types/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newtype := &TicketTimeAccountingType{}
+	err = json.Unmarshal(body, newtype)
+	if err != nil {
+		return nil, err
+	}
+	return newtype, nil
+}
+
+func (c *Client) UpdateTicketTimeAccountingType(tt *TicketTimeAccountingType) (*TicketTimeAccountingType, error) {
+	rb, err := json.Marshal(tt)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/ticket_time_accounting_types/"+strconv.Itoa(tt.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newtype := &TicketTimeAccountingType{}
+	err = json.Unmarshal(body, newtype)
+	if err != nil {
+		return nil, err
+	}
+	return newtype, nil
+}
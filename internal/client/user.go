@@ -0,0 +1,110 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type User struct {
+	ID             int                 `json:"id,omitempty"`
+	Login          string              `json:"login"`
+	Firstname      string              `json:"firstname"`
+	Lastname       string              `json:"lastname"`
+	Email          string              `json:"email"`
+	Phone          string              `json:"phone"`
+	Mobile         string              `json:"mobile"`
+	Web            string              `json:"web"`
+	Note           string              `json:"note"`
+	OrganizationID *int                `json:"organization_id"`
+	Active         bool                `json:"active"`
+	VIP            bool                `json:"vip"`
+	RoleIDs        []int               `json:"role_ids"`
+	GroupIDs       map[string][]string `json:"group_ids"`
+	LastLogin      *string             `json:"last_login,omitempty"`
+	CreatedAt      string              `json:"created_at,omitempty"`
+	UpdatedAt      string              `json:"updated_at,omitempty"`
+}
+
+func (c *Client) GetUser(id int) (*User, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/users/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newuser := &User{}
+	err = json.Unmarshal(body, newuser)
+	if err != nil {
+		return nil, err
+	}
+	return newuser, nil
+}
+
+// SearchUsers searches users, optionally only those with one of the given
+// roles. The results are split in pages of perPage users, starting at 1.
+func (c *Client) SearchUsers(query string, roleIDs []int, page, perPage int) ([]User, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("page", strconv.Itoa(page))
+	params.Set("per_page", strconv.Itoa(perPage))
+	for _, id := range roleIDs {
+		params.Add("role_ids[]", strconv.Itoa(id))
+	}
+	req, err := http.NewRequest("GET", c.host+"/api/v1/users/search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	users := []User{}
+	err = json.Unmarshal(body, &users)
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// UpdateUserGroupIDs replaces the group access of a user, keyed by group ID.
+// Only the group_ids are sent so other attributes of the user are untouched.
+func (c *Client) UpdateUserGroupIDs(id int, groupIDs map[string][]string) (*User, error) {
+	rb, err := json.Marshal(map[string]interface{}{"group_ids": groupIDs})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/users/"+strconv.Itoa(id), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newuser := &User{}
+	err = json.Unmarshal(body, newuser)
+	if err != nil {
+		return nil, err
+	}
+	return newuser, nil
+}
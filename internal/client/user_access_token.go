@@ -0,0 +1,118 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// UserAccessToken is an API token. Zammad calls the name of the token label
+// and the secret token value name.
+type UserAccessToken struct {
+	ID          int                         `json:"id,omitempty"`
+	Name        string                      `json:"label"`
+	Permission  []string                    `json:"permission,omitempty"`
+	ExpiresAt   *string                     `json:"expires_at,omitempty"`
+	Preferences *UserAccessTokenPreferences `json:"preferences,omitempty"`
+	// Token is only returned on creation.
+	Token      string  `json:"-"`
+	LastUsedAt *string `json:"last_used_at,omitempty"`
+	CreatedAt  string  `json:"created_at,omitempty"`
+	UpdatedAt  string  `json:"updated_at,omitempty"`
+}
+
+type UserAccessTokenPreferences struct {
+	Permission []string `json:"permission"`
+}
+
+// GetUserAccessTokens returns the access tokens of the authenticated user.
+func (c *Client) GetUserAccessTokens() ([]UserAccessToken, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/user_access_token", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	list := struct {
+		Tokens []UserAccessToken `json:"tokens"`
+	}{}
+	err = json.Unmarshal(body, &list)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Tokens {
+		if list.Tokens[i].Preferences != nil {
+			list.Tokens[i].Permission = list.Tokens[i].Preferences.Permission
+		}
+	}
+	return list.Tokens, nil
+}
+
+// CreateUserAccessToken creates an access token for the authenticated user.
+// Zammad only returns the token value, the new token is looked up by label
+// afterwards.
+func (c *Client) CreateUserAccessToken(token *UserAccessToken) (*UserAccessToken, error) {
+	rb, err := json.Marshal(token)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/user_access_token", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	created := struct {
+		Token string `json:"name"`
+	}{}
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := c.GetUserAccessTokens()
+	if err != nil {
+		return nil, err
+	}
+	var newtoken *UserAccessToken
+	for i := range tokens {
+		if tokens[i].Name == token.Name && (newtoken == nil || tokens[i].ID > newtoken.ID) {
+			newtoken = &tokens[i]
+		}
+	}
+	if newtoken == nil {
+		return nil, fmt.Errorf("user access token %q not found", token.Name)
+	}
+	newtoken.Token = created.Token
+	return newtoken, nil
+}
+
+func (c *Client) DeleteUserAccessToken(token *UserAccessToken) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/user_access_token/"+strconv.Itoa(token.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
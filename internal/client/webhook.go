@@ -0,0 +1,110 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+type Webhook struct {
+	ID                    int    `json:"id,omitempty"`
+	Name                  string `json:"name"`
+	Endpoint              string `json:"endpoint"`
+	SignatureToken        string `json:"signature_token"`
+	SSLVerify             bool   `json:"ssl_verify"`
+	BasicAuthUsername     string `json:"basic_auth_username"`
+	BasicAuthPassword     string `json:"basic_auth_password"`
+	CustomizedPayload     bool   `json:"customized_payload"`
+	CustomPayload         string `json:"custom_payload"`
+	PreDefinedWebhookType string `json:"pre_defined_webhook_type"`
+	Note                  string `json:"note"`
+	Active                bool   `json:"active"`
+	CreatedAt             string `json:"created_at,omitempty"`
+	UpdatedAt             string `json:"updated_at,omitempty"`
+	CreatedByID           int    `json:"created_by_id,omitempty"`
+	UpdatedByID           int    `json:"updated_by_id,omitempty"`
+}
+
+func (c *Client) CreateWebhook(wh *Webhook) (*Webhook, error) {
+	rb, err := json.Marshal(wh)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.host+"/api/v1/webhooks", bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newwh := &Webhook{}
+	err = json.Unmarshal(body, newwh)
+	if err != nil {
+		return nil, err
+	}
+	return newwh, nil
+}
+
+func (c *Client) GetWebhook(id int) (*Webhook, error) {
+	req, err := http.NewRequest("GET", c.host+"/api/v1/webhooks/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newwh := &Webhook{}
+	err = json.Unmarshal(body, newwh)
+	if err != nil {
+		return nil, err
+	}
+	return newwh, nil
+}
+
+func (c *Client) UpdateWebhook(wh *Webhook) (*Webhook, error) {
+	rb, err := json.Marshal(wh)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", c.host+"/api/v1/webhooks/"+strconv.Itoa(wh.ID), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	newwh := &Webhook{}
+	err = json.Unmarshal(body, newwh)
+	if err != nil {
+		return nil, err
+	}
+	return newwh, nil
+}
+
+func (c *Client) DeleteWebhook(wh *Webhook) error {
+	req, err := http.NewRequest("DELETE", c.host+"/api/v1/webhooks/"+strconv.Itoa(wh.ID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req)
+	return err
+}
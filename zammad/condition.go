@@ -0,0 +1,190 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// conditionRanges are the units of relative date operators.
+var conditionRanges = []string{"minute", "hour", "day", "week", "month", "year"}
+
+// scalarConditionOperators compare with a single value, which Zammad expects
+// as a string instead of a list.
+var scalarConditionOperators = []string{
+	"contains", "contains not", "starts with", "ends with",
+	"matches regex", "does not match regex", "regex match", "regex mismatch",
+	"before (absolute)", "after (absolute)",
+}
+
+// isRelativeOperator reports whether operator compares dates relative to now,
+// e.g. within next (relative), which needs a range.
+func isRelativeOperator(operator string) bool {
+	return strings.HasSuffix(operator, "(relative)")
+}
+
+var attributeKeyValidator = mapKeysMatchValidator{
+	re:      regexp.MustCompile(`^[a-z_]+\.[a-z0-9_.]+$`),
+	message: "an attribute such as ticket.state_id",
+}
+
+// conditionSchema returns the schema of a selector, a map of conditions keyed
+// by the attribute they check.
+func conditionSchema(description string, required bool) schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		Required:    required,
+		Optional:    !required,
+		Description: description,
+		Validators:  []validator.Map{attributeKeyValidator},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"operator": schema.StringAttribute{
+					Required:    true,
+					Description: "Operator of the condition, e.g. is, is not, contains or before (relative).",
+				},
+				"value": schema.ListAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "Values to compare with. IDs are given as strings. Operators comparing with a single value, such as contains or within next (relative), take exactly one.",
+				},
+				"range": schema.StringAttribute{
+					Optional:    true,
+					Description: "Unit of the value of relative operators such as within last (relative): " + strings.Join(conditionRanges, ", ") + ".",
+					Validators:  []validator.String{stringOneOfValidator{values: conditionRanges}},
+				},
+				"pre_condition": schema.StringAttribute{
+					Optional:    true,
+					Description: "Pre-condition of user and organization attributes: specific, current_user.id, current_user.organization_id or not_set.",
+				},
+			},
+			Validators: []validator.Object{conditionValidator{}},
+		},
+	}
+}
+
+func conditionsToClient(conditions map[string]Condition) map[string]client.Condition {
+	result := make(map[string]client.Condition, len(conditions))
+	for key, c := range conditions {
+		cond := client.Condition{
+			Operator:     c.Operator.ValueString(),
+			PreCondition: c.PreCondition.ValueString(),
+		}
+		if c.Value != nil {
+			values := stringsFromValues(c.Value)
+			if len(values) == 1 && (isRelativeOperator(cond.Operator) || stringInSlice(cond.Operator, scalarConditionOperators)) {
+				cond.Value = values[0]
+			} else {
+				cond.Value = values
+			}
+		}
+		if !c.Range.IsNull() {
+			cond.Range = c.Range.ValueString()
+		}
+		result[key] = cond
+	}
+	return result
+}
+
+func conditionsFromClient(conditions map[string]client.Condition) map[string]Condition {
+	if len(conditions) == 0 {
+		return nil
+	}
+	result := make(map[string]Condition, len(conditions))
+	for key, c := range conditions {
+		cond := Condition{
+			Operator:     types.StringValue(c.Operator),
+			Value:        stringValuesFromJSON(c.Value),
+			Range:        types.StringNull(),
+			PreCondition: types.StringNull(),
+		}
+		if c.Range != "" {
+			cond.Range = types.StringValue(c.Range)
+		}
+		if c.PreCondition != "" {
+			cond.PreCondition = types.StringValue(c.PreCondition)
+		}
+		result[key] = cond
+	}
+	return result
+}
+
+func stringsFromValues(values []types.String) []string {
+	result := make([]string, len(values))
+	for i := range values {
+		result[i] = values[i].ValueString()
+	}
+	return result
+}
+
+// stringValuesFromJSON converts a decoded JSON scalar or list of scalars, as
+// Zammad uses both for the same fields, to a list of strings.
+func stringValuesFromJSON(v interface{}) []types.String {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		result := make([]types.String, 0, len(v))
+		for _, e := range v {
+			result = append(result, types.StringValue(jsonScalarString(e)))
+		}
+		return result
+	default:
+		return []types.String{types.StringValue(jsonScalarString(v))}
+	}
+}
+
+// conditionValidator checks that relative operators have a range and a single
+// value.
+type conditionValidator struct{}
+
+func (v conditionValidator) Description(ctx context.Context) string {
+	return "relative operators require a range and exactly one value"
+}
+
+func (v conditionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v conditionValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var cond Condition
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, &cond, types.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || cond.Operator.IsUnknown() {
+		return
+	}
+	operator := cond.Operator.ValueString()
+	if isRelativeOperator(operator) {
+		if cond.Range.IsNull() {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName("range"), "Missing range", fmt.Sprintf("The %q operator requires a range such as day.", operator))
+		}
+		if len(cond.Value) != 1 {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName("value"), "Invalid value", fmt.Sprintf("The %q operator requires exactly one value.", operator))
+		}
+	} else if !cond.Range.IsNull() {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("range"), "Invalid range", fmt.Sprintf("A range is only used by relative operators, not %q.", operator))
+	}
+}
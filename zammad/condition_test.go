@@ -0,0 +1,72 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func TestConditionsToClient(t *testing.T) {
+	conditions := map[string]Condition{
+		"ticket.state_id": {
+			Operator:     types.StringValue("is"),
+			Value:        []types.String{types.StringValue("1")},
+			Range:        types.StringNull(),
+			PreCondition: types.StringNull(),
+		},
+		"ticket.title": {
+			Operator:     types.StringValue("contains"),
+			Value:        []types.String{types.StringValue("outage")},
+			Range:        types.StringNull(),
+			PreCondition: types.StringNull(),
+		},
+		"ticket.created_at": {
+			Operator:     types.StringValue("within last (relative)"),
+			Value:        []types.String{types.StringValue("7")},
+			Range:        types.StringValue("day"),
+			PreCondition: types.StringNull(),
+		},
+	}
+	want := map[string]client.Condition{
+		"ticket.state_id":   {Operator: "is", Value: []string{"1"}},
+		"ticket.title":      {Operator: "contains", Value: "outage"},
+		"ticket.created_at": {Operator: "within last (relative)", Value: "7", Range: "day"},
+	}
+	got := conditionsToClient(conditions)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("conditionsToClient() = %#v, want %#v", got, want)
+	}
+
+	// Zammad returns the conditions as JSON.
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]client.Condition
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	back := conditionsFromClient(decoded)
+	if !reflect.DeepEqual(back, conditions) {
+		t.Fatalf("conditionsFromClient() = %#v, want %#v", back, conditions)
+	}
+}
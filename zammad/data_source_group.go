@@ -0,0 +1,186 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadGroupDataSource() datasource.DataSource {
+	return &dataSourceGroup{}
+}
+
+type dataSourceGroup struct {
+	client *client.Client
+}
+
+// Group Data Source schema
+func (d dataSourceGroup) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a group by exactly one of id or name.",
+		Attributes:  lookupByIDOrName(groupAttributes()),
+	}
+}
+
+func (d *dataSourceGroup) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *dataSourceGroup) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig checks that exactly one of id or name is set.
+func (d dataSourceGroup) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateIDOrName(ctx, req.Config)...)
+}
+
+// Read data source information
+func (d dataSourceGroup) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GroupResult
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.client.GetGroups()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group",
+			"Could not read groups: "+err.Error(),
+		)
+		return
+	}
+	for i := range groups {
+		if matchesIDOrName(config.ID, config.Name, groups[i].ID, groups[i].Name) {
+			diags = resp.State.Set(ctx, groupResultFromClient(&groups[i]))
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError(
+		"Error reading group",
+		"No group found for "+idOrName(config.ID, config.Name)+".",
+	)
+}
+
+// groupAttributes are the computed attributes of a group in the zammad_group
+// and zammad_groups data sources.
+func groupAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"email_address_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "ID of the email address used for outgoing emails.",
+		},
+		"signature_id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"assignment_timeout": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Minutes after which an unanswered ticket is unassigned.",
+		},
+		"follow_up_possible": schema.StringAttribute{
+			Computed: true,
+		},
+		"follow_up_assignment": schema.BoolAttribute{
+			Computed: true,
+		},
+		"note": schema.StringAttribute{
+			Computed: true,
+		},
+		"active": schema.BoolAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func groupResultFromClient(group *client.Group) GroupResult {
+	return GroupResult{
+		ID:                 types.Int64Value(int64(group.ID)),
+		Name:               types.StringValue(group.Name),
+		EmailAddressID:     int64PointerToValue(group.EmailAddressID),
+		SignatureID:        int64PointerToValue(group.SignatureID),
+		AssignmentTimeout:  int64PointerToValue(group.AssignmentTimeout),
+		FollowUpPossible:   types.StringValue(group.FollowUpPossible),
+		FollowUpAssignment: types.BoolValue(group.FollowUpAssignment),
+		Note:               types.StringValue(group.Note),
+		Active:             types.BoolValue(group.Active),
+	}
+}
+
+// lookupByIDOrName makes the id and name of attributes configurable, to look
+// up a single object.
+func lookupByIDOrName(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["id"] = schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+	}
+	return attributes
+}
+
+// validateIDOrName checks that exactly one of id or name is configured.
+func validateIDOrName(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var id types.Int64
+	var name types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("id"), &id)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if diags.HasError() || id.IsUnknown() || name.IsUnknown() {
+		return diags
+	}
+	if id.IsNull() == name.IsNull() {
+		diags.AddAttributeError(path.Root("id"), "Invalid lookup", "Exactly one of id or name must be set.")
+	}
+	return diags
+}
+
+func matchesIDOrName(id types.Int64, name types.String, objectID int, objectName string) bool {
+	if !id.IsNull() {
+		return id.ValueInt64() == int64(objectID)
+	}
+	return name.ValueString() == objectName
+}
+
+func idOrName(id types.Int64, name types.String) string {
+	if !id.IsNull() {
+		return "id " + strconv.FormatInt(id.ValueInt64(), 10)
+	}
+	return "name " + strconv.Quote(name.ValueString())
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"regexp"
+	"testing"
+
+	tfdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfdatasource.DataSourceWithSchema = &dataSourceGroup{}
+
+func TestAccGroupDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "zammad_group" "test" {}
+`,
+				ExpectError: regexp.MustCompile("Invalid lookup"),
+			},
+			{
+				Config: `
+data "zammad_group" "by_name" {
+	name = "Users"
+}
+
+data "zammad_group" "by_id" {
+	id = data.zammad_group.by_name.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zammad_group.by_name", "active", "true"),
+					resource.TestCheckResourceAttr("data.zammad_group.by_id", "name", "Users"),
+				),
+			},
+		},
+	})
+}
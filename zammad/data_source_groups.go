@@ -0,0 +1,91 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadGroupsDataSource() datasource.DataSource {
+	return &dataSourceGroups{}
+}
+
+type dataSourceGroups struct {
+	client *client.Client
+}
+
+// Groups Data Source schema
+func (d dataSourceGroups) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All groups, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: groupAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceGroups) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *dataSourceGroups) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Read data source information
+func (d dataSourceGroups) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	groups, err := d.client.GetGroups()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading groups",
+			"Could not read groups: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	state := Groups{
+		ID:     types.StringValue("groups"),
+		Groups: make([]GroupResult, len(groups)),
+	}
+	for i := range groups {
+		state.Groups[i] = groupResultFromClient(&groups[i])
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
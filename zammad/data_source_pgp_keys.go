@@ -0,0 +1,146 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadPGPKeysDataSource() datasource.DataSource {
+	return &dataSourcePGPKeys{}
+}
+
+type dataSourcePGPKeys struct {
+	client *client.Client
+}
+
+// PGPKeys Data Source schema
+func (d dataSourcePGPKeys) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "PGP keys of the PGP integration. A warning is emitted for each key that expired or expires soon.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"expiry_warning_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Warn about keys expiring within this number of days. Defaults to %d, 0 disables the warnings.", defaultExpiryWarningDays),
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All keys, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"fingerprint": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"email_addresses": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Expiry of the key, null if it does not expire.",
+						},
+						"secret": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the key contains a private key.",
+						},
+						"domain_alias": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourcePGPKeys) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pgp_keys"
+}
+
+func (d *dataSourcePGPKeys) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Read data source information
+func (d dataSourcePGPKeys) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config PGPKeys
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := d.client.GetPGPKeys()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading PGP keys",
+			"Could not read PGP keys: "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	state := PGPKeys{
+		ID:                types.StringValue("pgp_keys"),
+		ExpiryWarningDays: config.ExpiryWarningDays,
+		Keys:              make([]PGPKeyInfo, len(keys)),
+	}
+	days := expiryWarningDays(config.ExpiryWarningDays)
+	now := time.Now()
+	for i, k := range keys {
+		state.Keys[i] = PGPKeyInfo{
+			ID:             types.StringValue(strconv.Itoa(k.ID)),
+			Fingerprint:    types.StringValue(k.Fingerprint),
+			Name:           types.StringValue(k.Name),
+			EmailAddresses: stringListValue(k.EmailAddresses),
+			ExpiresAt:      types.StringNull(),
+			Secret:         types.BoolValue(k.Secret),
+			DomainAlias:    types.StringValue(k.DomainAlias),
+		}
+		if k.ExpiresAt != nil {
+			state.Keys[i].ExpiresAt = types.StringValue(*k.ExpiresAt)
+			expiryWarning(&resp.Diagnostics, "PGP key "+k.Name, *k.ExpiresAt, days, now)
+		}
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
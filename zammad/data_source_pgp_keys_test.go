@@ -0,0 +1,53 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+
+	tfdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfdatasource.DataSourceWithSchema = &dataSourcePGPKeys{}
+
+func TestAccPGPKeysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "zammad_pgp_key" "test" {
+	key = <<EOT
+%s
+EOT
+}
+
+data "zammad_pgp_keys" "all" {
+	depends_on = [zammad_pgp_key.test]
+}
+`, testAccPGPPublicKey(t, "listed-pgp@example.com")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.zammad_pgp_keys.all", "keys.*", map[string]string{
+						"email_addresses.0": "listed-pgp@example.com",
+						"secret":            "false",
+					}),
+				),
+			},
+		},
+	})
+}
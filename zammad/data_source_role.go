@@ -0,0 +1,121 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadRoleDataSource() datasource.DataSource {
+	return &dataSourceRole{}
+}
+
+type dataSourceRole struct {
+	client *client.Client
+}
+
+// Role Data Source schema
+func (d dataSourceRole) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a role by exactly one of id or name.",
+		Attributes:  lookupByIDOrName(roleAttributes()),
+	}
+}
+
+func (d *dataSourceRole) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *dataSourceRole) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig checks that exactly one of id or name is set.
+func (d dataSourceRole) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateIDOrName(ctx, req.Config)...)
+}
+
+// Read data source information
+func (d dataSourceRole) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config RoleResult
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.client.GetRoles()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading role",
+			"Could not read roles: "+err.Error(),
+		)
+		return
+	}
+	for i := range roles {
+		if matchesIDOrName(config.ID, config.Name, roles[i].ID, roles[i].Name) {
+			diags = resp.State.Set(ctx, roleResultFromClient(&roles[i]))
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError(
+		"Error reading role",
+		"No role found for "+idOrName(config.ID, config.Name)+".",
+	)
+}
+
+// roleAttributes are the computed attributes of a role in the zammad_role and
+// zammad_roles data sources.
+func roleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"default_at_signup": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the role is assigned to new users signing up.",
+		},
+		"note": schema.StringAttribute{
+			Computed: true,
+		},
+		"active": schema.BoolAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func roleResultFromClient(role *client.Role) RoleResult {
+	return RoleResult{
+		ID:              types.Int64Value(int64(role.ID)),
+		Name:            types.StringValue(role.Name),
+		DefaultAtSignup: types.BoolValue(role.DefaultAtSignup),
+		Note:            types.StringValue(role.Note),
+		Active:          types.BoolValue(role.Active),
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// defaultExpiryWarningDays is the default of expiry_warning_days.
+const defaultExpiryWarningDays = 30
+
+func NewZammadSMIMECertificatesDataSource() datasource.DataSource {
+	return &dataSourceSMIMECertificates{}
+}
+
+type dataSourceSMIMECertificates struct {
+	client *client.Client
+}
+
+// SMIMECertificates Data Source schema
+func (d dataSourceSMIMECertificates) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "S/MIME certificates of the S/MIME integration. A warning is emitted for each certificate that expired or expires soon.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"expiry_warning_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Warn about certificates expiring within this number of days. Defaults to %d, 0 disables the warnings.", defaultExpiryWarningDays),
+			},
+			"certificates": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All certificates, sorted by expiry.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"fingerprint": schema.StringAttribute{
+							Computed: true,
+						},
+						"subject": schema.StringAttribute{
+							Computed: true,
+						},
+						"email_addresses": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"not_before": schema.StringAttribute{
+							Computed: true,
+						},
+						"not_after": schema.StringAttribute{
+							Computed: true,
+						},
+						"has_private_key": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceSMIMECertificates) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smime_certificates"
+}
+
+func (d *dataSourceSMIMECertificates) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Read data source information
+func (d dataSourceSMIMECertificates) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SMIMECertificates
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certs, err := d.client.GetSMIMECertificates()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading S/MIME certificates",
+			"Could not read S/MIME certificates: "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(certs, func(i, j int) bool { return certs[i].NotAfterAt < certs[j].NotAfterAt })
+	state := SMIMECertificates{
+		ID:                types.StringValue("smime_certificates"),
+		ExpiryWarningDays: config.ExpiryWarningDays,
+		Certificates:      make([]SMIMECertificateInfo, len(certs)),
+	}
+	days := expiryWarningDays(config.ExpiryWarningDays)
+	now := time.Now()
+	for i, c := range certs {
+		state.Certificates[i] = SMIMECertificateInfo{
+			ID:             types.StringValue(strconv.Itoa(c.ID)),
+			Fingerprint:    types.StringValue(c.Fingerprint),
+			Subject:        types.StringValue(c.Subject),
+			EmailAddresses: stringListValue(c.EmailAddresses),
+			NotBefore:      types.StringValue(c.NotBeforeAt),
+			NotAfter:       types.StringValue(c.NotAfterAt),
+			HasPrivateKey:  types.BoolValue(c.PrivateKey != ""),
+		}
+		expiryWarning(&resp.Diagnostics, "S/MIME certificate "+c.Subject, c.NotAfterAt, days, now)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func expiryWarningDays(v types.Int64) int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return defaultExpiryWarningDays
+	}
+	return v.ValueInt64()
+}
+
+// expiryWarning adds a warning to diags if expires is within days from now.
+func expiryWarning(diags *diag.Diagnostics, what, expires string, days int64, now time.Time) {
+	if days <= 0 || expires == "" {
+		return
+	}
+	t, err := time.Parse(time.RFC3339, expires)
+	if err != nil {
+		return
+	}
+	switch {
+	case t.Before(now):
+		diags.AddWarning(
+			"Expired "+what,
+			fmt.Sprintf("The %s expired at %s.", what, expires),
+		)
+	case t.Before(now.Add(time.Duration(days) * 24 * time.Hour)):
+		diags.AddWarning(
+			"Expiring "+what,
+			fmt.Sprintf("The %s expires at %s, in %d days.", what, expires, int(t.Sub(now).Hours()/24)),
+		)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tfdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfdatasource.DataSourceWithSchema = &dataSourceSMIMECertificates{}
+
+func TestAccSMIMECertificatesDataSource(t *testing.T) {
+	cert, _ := testAccSMIMECertificate(t, "expiring@example.com", 7*24*time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "zammad_smime_certificate" "test" {
+	certificate = <<EOT
+%sEOT
+}
+
+data "zammad_smime_certificates" "all" {
+	expiry_warning_days = 14
+	depends_on          = [zammad_smime_certificate.test]
+}
+`, cert),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.zammad_smime_certificates.all", "certificates.*", map[string]string{
+						"email_addresses.0": "expiring@example.com",
+						"has_private_key":   "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestExpiryWarning(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		expires  string
+		days     int64
+		warnings int
+	}{
+		{"2022-12-01T00:00:00.000Z", 30, 1},
+		{"2023-01-15T00:00:00.000Z", 30, 1},
+		{"2023-03-01T00:00:00.000Z", 30, 0},
+		{"2023-01-15T00:00:00.000Z", 0, 0},
+		{"", 30, 0},
+	} {
+		var diags diag.Diagnostics
+		expiryWarning(&diags, "certificate", tc.expires, tc.days, now)
+		if diags.WarningsCount() != tc.warnings {
+			t.Errorf("expiryWarning(%q, %d): got %d warnings, want %d", tc.expires, tc.days, diags.WarningsCount(), tc.warnings)
+		}
+	}
+}
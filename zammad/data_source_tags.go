@@ -0,0 +1,102 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadTagsDataSource() datasource.DataSource {
+	return &dataSourceTags{}
+}
+
+type dataSourceTags struct {
+	client *client.Client
+}
+
+// Tags Data Source schema
+func (d dataSourceTags) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All tags, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of tickets the tag is used on.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTags) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *dataSourceTags) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Read data source information
+func (d dataSourceTags) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tags, err := d.client.GetTags()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading tags",
+			"Could not read tags: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+	state := Tags{
+		ID:   types.StringValue("tags"),
+		Tags: make([]Tag, len(tags)),
+	}
+	for i := range tags {
+		state.Tags[i] = tagFromClient(&tags[i])
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadTicketArticlesDataSource() datasource.DataSource {
+	return &dataSourceTicketArticles{}
+}
+
+type dataSourceTicketArticles struct {
+	client *client.Client
+}
+
+// TicketArticles Data Source schema
+func (d dataSourceTicketArticles) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"ticket_id": schema.Int64Attribute{
+				Required: true,
+			},
+			"articles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Articles of the ticket, oldest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"subject": schema.StringAttribute{
+							Computed: true,
+						},
+						"body": schema.StringAttribute{
+							Computed: true,
+						},
+						"content_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"sender": schema.StringAttribute{
+							Computed: true,
+						},
+						"internal": schema.BoolAttribute{
+							Computed: true,
+						},
+						"from": schema.StringAttribute{
+							Computed: true,
+						},
+						"to": schema.StringAttribute{
+							Computed: true,
+						},
+						"cc": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_by_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTicketArticles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_articles"
+}
+
+func (d *dataSourceTicketArticles) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Read data source information
+func (d dataSourceTicketArticles) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TicketArticles
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ticketID := int(state.TicketID.ValueInt64())
+	articles, err := d.client.GetTicketArticles(ticketID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ticket articles",
+			"Could not read articles of ticket "+strconv.Itoa(ticketID)+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(strconv.Itoa(ticketID))
+	state.Articles = make([]TicketArticle, len(articles))
+	for i, a := range articles {
+		state.Articles[i] = TicketArticle{
+			ID:          types.Int64Value(int64(a.ID)),
+			Subject:     types.StringValue(a.Subject),
+			Body:        types.StringValue(a.Body),
+			ContentType: types.StringValue(a.ContentType),
+			Type:        types.StringValue(a.Type),
+			Sender:      types.StringValue(a.Sender),
+			Internal:    types.BoolValue(a.Internal),
+			From:        types.StringValue(a.From),
+			To:          types.StringValue(a.To),
+			Cc:          types.StringValue(a.Cc),
+			CreatedByID: types.Int64Value(int64(a.CreatedByID)),
+			CreatedAt:   types.StringValue(a.CreatedAt),
+		}
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
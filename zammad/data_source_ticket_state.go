@@ -0,0 +1,137 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadTicketStateDataSource() datasource.DataSource {
+	return &dataSourceTicketState{}
+}
+
+type dataSourceTicketState struct {
+	client *client.Client
+}
+
+// TicketState Data Source schema
+func (d dataSourceTicketState) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a ticket state by exactly one of id or name.",
+		Attributes:  lookupByIDOrName(ticketStateAttributes()),
+	}
+}
+
+func (d *dataSourceTicketState) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_state"
+}
+
+func (d *dataSourceTicketState) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig checks that exactly one of id or name is set.
+func (d dataSourceTicketState) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateIDOrName(ctx, req.Config)...)
+}
+
+// Read data source information
+func (d dataSourceTicketState) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TicketStateResult
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ticketStates, err := d.client.GetTicketStates()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ticket state",
+			"Could not read ticket states: "+err.Error(),
+		)
+		return
+	}
+	for i := range ticketStates {
+		if matchesIDOrName(config.ID, config.Name, ticketStates[i].ID, ticketStates[i].Name) {
+			diags = resp.State.Set(ctx, ticketStateResultFromClient(&ticketStates[i]))
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError(
+		"Error reading ticket state",
+		"No ticket state found for "+idOrName(config.ID, config.Name)+".",
+	)
+}
+
+// ticketStateAttributes are the computed attributes of a ticket state in the
+// zammad_ticket_state and zammad_ticket_states data sources.
+func ticketStateAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"state_type_id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"next_state_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "State a pending ticket changes to, null for other states.",
+		},
+		"ignore_escalation": schema.BoolAttribute{
+			Computed: true,
+		},
+		"default_create": schema.BoolAttribute{
+			Computed: true,
+		},
+		"default_follow_up": schema.BoolAttribute{
+			Computed: true,
+		},
+		"note": schema.StringAttribute{
+			Computed: true,
+		},
+		"active": schema.BoolAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func ticketStateResultFromClient(state *client.TicketState) TicketStateResult {
+	return TicketStateResult{
+		ID:               types.Int64Value(int64(state.ID)),
+		Name:             types.StringValue(state.Name),
+		StateTypeID:      types.Int64Value(int64(state.StateTypeID)),
+		NextStateID:      int64PointerToValue(state.NextStateID),
+		IgnoreEscalation: types.BoolValue(state.IgnoreEscalation),
+		DefaultCreate:    types.BoolValue(state.DefaultCreate),
+		DefaultFollowUp:  types.BoolValue(state.DefaultFollowUp),
+		Note:             types.StringValue(state.Note),
+		Active:           types.BoolValue(state.Active),
+	}
+}
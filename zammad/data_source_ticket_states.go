@@ -0,0 +1,91 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadTicketStatesDataSource() datasource.DataSource {
+	return &dataSourceTicketStates{}
+}
+
+type dataSourceTicketStates struct {
+	client *client.Client
+}
+
+// TicketStates Data Source schema
+func (d dataSourceTicketStates) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"ticket_states": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All ticket states, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ticketStateAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTicketStates) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_states"
+}
+
+func (d *dataSourceTicketStates) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Read data source information
+func (d dataSourceTicketStates) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ticketStates, err := d.client.GetTicketStates()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ticket states",
+			"Could not read ticket states: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(ticketStates, func(i, j int) bool { return ticketStates[i].Name < ticketStates[j].Name })
+	state := TicketStates{
+		ID:           types.StringValue("ticket_states"),
+		TicketStates: make([]TicketStateResult, len(ticketStates)),
+	}
+	for i := range ticketStates {
+		state.TicketStates[i] = ticketStateResultFromClient(&ticketStates[i])
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// ticketsDefaultLimit is the number of tickets returned if no limit is given,
+// the same as in Zammad.
+const ticketsDefaultLimit = 10
+
+func NewZammadTicketsDataSource() datasource.DataSource {
+	return &dataSourceTickets{}
+}
+
+type dataSourceTickets struct {
+	client *client.Client
+}
+
+// Tickets Data Source schema
+func (d dataSourceTickets) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query": schema.StringAttribute{
+				Required:    true,
+				Description: "Search query, e.g. state.name:open AND tags:runbook.",
+			},
+			"sort_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "Attribute to sort by, e.g. created_at.",
+			},
+			"order_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "Sort order, asc or desc.",
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{"asc", "desc"},
+				}},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of tickets to return. Defaults to 10.",
+			},
+			"tickets": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"number": schema.StringAttribute{
+							Computed: true,
+						},
+						"title": schema.StringAttribute{
+							Computed: true,
+						},
+						"group_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"customer_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"state_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"state": schema.StringAttribute{
+							Computed: true,
+						},
+						"priority_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"priority": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"updated_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTickets) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tickets"
+}
+
+func (d *dataSourceTickets) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Read data source information
+func (d dataSourceTickets) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Tickets
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := ticketsDefaultLimit
+	if !state.Limit.IsNull() {
+		limit = int(state.Limit.ValueInt64())
+	}
+
+	tickets, err := d.client.SearchTickets(state.Query.ValueString(), state.SortBy.ValueString(), state.OrderBy.ValueString(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error searching tickets",
+			"Could not search tickets: "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = state.Query
+	state.Tickets = make([]TicketResult, len(tickets))
+	for i, t := range tickets {
+		state.Tickets[i] = TicketResult{
+			ID:         types.Int64Value(int64(t.ID)),
+			Number:     types.StringValue(t.Number),
+			Title:      types.StringValue(t.Title),
+			GroupID:    types.Int64Value(int64(t.GroupID)),
+			CustomerID: types.Int64Value(int64(t.CustomerID)),
+			StateID:    types.Int64Value(int64(t.StateID)),
+			State:      types.StringValue(t.State),
+			PriorityID: types.Int64Value(int64(t.PriorityID)),
+			Priority:   types.StringValue(t.Priority),
+			CreatedAt:  types.StringValue(t.CreatedAt),
+			UpdatedAt:  types.StringValue(t.UpdatedAt),
+		}
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"testing"
+
+	tfdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfdatasource.DataSourceWithSchema = &dataSourceTickets{}
+
+func TestAccTicketsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "zammad_ticket" "test" {
+	title       = "Searchable runbook"
+	group_id    = 1
+	customer_id = 1
+	article = {
+		body = "Steps to follow."
+	}
+}
+
+data "zammad_tickets" "test" {
+	query    = "title:\"${zammad_ticket.test.title}\""
+	sort_by  = "created_at"
+	order_by = "desc"
+	limit    = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zammad_tickets.test", "tickets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.zammad_tickets.test", "tickets.0.number", "zammad_ticket.test", "number"),
+					resource.TestCheckResourceAttr("data.zammad_tickets.test", "tickets.0.title", "Searchable runbook"),
+					resource.TestCheckResourceAttrSet("data.zammad_tickets.test", "tickets.0.state"),
+					resource.TestCheckResourceAttrSet("data.zammad_tickets.test", "tickets.0.priority"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,227 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// userLookupLimit is the number of search results checked for an exact login
+// or email match.
+const userLookupLimit = 100
+
+func NewZammadUserDataSource() datasource.DataSource {
+	return &dataSourceUser{}
+}
+
+type dataSourceUser struct {
+	client *client.Client
+}
+
+// User Data Source schema
+func (d dataSourceUser) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+	}
+	attributes["login"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+	}
+	attributes["email"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Email address of the user, matched case-insensitively.",
+	}
+	resp.Schema = schema.Schema{
+		Description: "Looks up a user by exactly one of id, login or email.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *dataSourceUser) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *dataSourceUser) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig checks that exactly one lookup attribute is set.
+func (d dataSourceUser) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config UserResult
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.ID.IsUnknown() || config.Login.IsUnknown() || config.Email.IsUnknown() {
+		return
+	}
+	set := 0
+	for _, isNull := range []bool{config.ID.IsNull(), config.Login.IsNull(), config.Email.IsNull()} {
+		if !isNull {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid user lookup", "Exactly one of id, login or email must be set.")
+	}
+}
+
+// Read data source information
+func (d dataSourceUser) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config UserResult
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *client.User
+	var err error
+	var lookup string
+	switch {
+	case !config.ID.IsNull():
+		lookup = strconv.FormatInt(config.ID.ValueInt64(), 10)
+		user, err = d.client.GetUser(int(config.ID.ValueInt64()))
+	case !config.Login.IsNull():
+		lookup = config.Login.ValueString()
+		user, err = d.findUser("login", lookup, func(u *client.User) bool { return u.Login == lookup })
+	default:
+		lookup = config.Email.ValueString()
+		user, err = d.findUser("email", lookup, func(u *client.User) bool { return strings.EqualFold(u.Email, lookup) })
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			"Could not read user "+lookup+": "+err.Error(),
+		)
+		return
+	}
+	if user == nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			"No user found for "+lookup+".",
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, userResultFromClient(user))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findUser searches for value in field and returns the first exact match. The
+// search also matches substrings, so a full page without a match is an error
+// rather than a missing user.
+func (d dataSourceUser) findUser(field, value string, match func(*client.User) bool) (*client.User, error) {
+	users, err := d.client.SearchUsers(field+":"+strconv.Quote(value), nil, 1, userLookupLimit)
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if match(&users[i]) {
+			return &users[i], nil
+		}
+	}
+	if len(users) >= userLookupLimit {
+		return nil, fmt.Errorf("no exact match in the first %d search results", userLookupLimit)
+	}
+	return nil, nil
+}
+
+// userAttributes are the computed attributes of a user in the zammad_user and
+// zammad_users data sources.
+func userAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"login": schema.StringAttribute{
+			Computed: true,
+		},
+		"email": schema.StringAttribute{
+			Computed: true,
+		},
+		"firstname": schema.StringAttribute{
+			Computed: true,
+		},
+		"lastname": schema.StringAttribute{
+			Computed: true,
+		},
+		"organization_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "ID of the organization of the user, null if the user has none.",
+		},
+		"active": schema.BoolAttribute{
+			Computed: true,
+		},
+		"vip": schema.BoolAttribute{
+			Computed: true,
+		},
+		"role_ids": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+		},
+		"group_ids": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.SetType{ElemType: types.StringType},
+			Description: "Access levels of the user, keyed by group ID.",
+		},
+	}
+}
+
+func userResultFromClient(user *client.User) UserResult {
+	groupIDs := map[string]attr.Value{}
+	for id, access := range user.GroupIDs {
+		sort.Strings(access)
+		elems := make([]attr.Value, len(access))
+		for i := range access {
+			elems[i] = types.StringValue(access[i])
+		}
+		groupIDs[id] = types.SetValueMust(types.StringType, elems)
+	}
+	return UserResult{
+		ID:             types.Int64Value(int64(user.ID)),
+		Login:          types.StringValue(user.Login),
+		Email:          types.StringValue(user.Email),
+		Firstname:      types.StringValue(user.Firstname),
+		Lastname:       types.StringValue(user.Lastname),
+		OrganizationID: int64PointerToValue(user.OrganizationID),
+		Active:         types.BoolValue(user.Active),
+		VIP:            types.BoolValue(user.VIP),
+		RoleIDs:        int64SetValue(user.RoleIDs),
+		GroupIDs:       types.MapValueMust(types.SetType{ElemType: types.StringType}, groupIDs),
+	}
+}
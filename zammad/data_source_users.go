@@ -0,0 +1,144 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// usersDefaultLimit is the number of users searched if no limit is given, the
+// same as in Zammad.
+const usersDefaultLimit = 10
+
+func NewZammadUsersDataSource() datasource.DataSource {
+	return &dataSourceUsers{}
+}
+
+type dataSourceUsers struct {
+	client *client.Client
+}
+
+// Users Data Source schema
+func (d dataSourceUsers) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query": schema.StringAttribute{
+				Required:    true,
+				Description: "Search query, e.g. a name, login or email address. Use * to list all users.",
+			},
+			"role_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "Only return users with one of these roles.",
+			},
+			"organization_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only return users of this organization. Applied to the search results, further pages are searched until limit users match.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return active or inactive users. Applied to the search results, further pages are searched until limit users match.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of users to return. Defaults to 10.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceUsers) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *dataSourceUsers) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Read data source information
+func (d dataSourceUsers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Users
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := usersDefaultLimit
+	if !state.Limit.IsNull() {
+		limit = int(state.Limit.ValueInt64())
+	}
+	var roleIDs []int
+	if !state.RoleIDs.IsNull() {
+		resp.Diagnostics.Append(state.RoleIDs.ElementsAs(ctx, &roleIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state.ID = state.Query
+	state.Users = []UserResult{}
+	for page := 1; len(state.Users) < limit; page++ {
+		users, err := d.client.SearchUsers(state.Query.ValueString(), roleIDs, page, limit)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error searching users",
+				"Could not search users: "+err.Error(),
+			)
+			return
+		}
+		for i := range users {
+			u := &users[i]
+			if !state.OrganizationID.IsNull() && (u.OrganizationID == nil || int64(*u.OrganizationID) != state.OrganizationID.ValueInt64()) {
+				continue
+			}
+			if !state.Active.IsNull() && u.Active != state.Active.ValueBool() {
+				continue
+			}
+			if len(state.Users) < limit {
+				state.Users = append(state.Users, userResultFromClient(u))
+			}
+		}
+		// A short page is the last one.
+		if len(users) < limit {
+			break
+		}
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
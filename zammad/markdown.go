@@ -0,0 +1,82 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+const (
+	contentFormatHTML     = "html"
+	contentFormatMarkdown = "markdown"
+)
+
+var markdown = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	// Zammad sanitizes the HTML it stores, raw HTML in the markdown source is
+	// passed through so it can be used for what markdown cannot express.
+	goldmark.WithRendererOptions(html.WithUnsafe()),
+)
+
+// markdownToHTML converts markdown to the HTML sent to Zammad.
+func markdownToHTML(source string) (string, error) {
+	var buf bytes.Buffer
+	if err := markdown.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+var (
+	htmlSpaceAroundTagsRe = regexp.MustCompile(`\s*(<[^>]*>)\s*`)
+	htmlSpaceRe           = regexp.MustCompile(`\s+`)
+)
+
+// normalizeHTML removes the formatting differences between the HTML we send
+// and the HTML Zammad returns. Whitespace next to tags is dropped, which can
+// hide a change that only consists of such whitespace.
+func normalizeHTML(content string) string {
+	content = htmlSpaceAroundTagsRe.ReplaceAllString(content, "$1")
+	content = htmlSpaceRe.ReplaceAllString(content, " ")
+	content = strings.ReplaceAll(content, "<br/>", "<br>")
+	content = strings.ReplaceAll(content, "<br />", "<br>")
+	return strings.TrimSpace(content)
+}
+
+// contentToHTML returns the HTML for content in the given format.
+func contentToHTML(content, format string) (string, error) {
+	if format == contentFormatMarkdown {
+		return markdownToHTML(content)
+	}
+	return content, nil
+}
+
+// contentFromHTML returns the content to store in the state for the HTML
+// returned by Zammad. The prior content is kept as long as it renders to the
+// same HTML, so that markdown sources and formatting do not cause a diff.
+func contentFromHTML(remote, prior, format string) string {
+	priorHTML, err := contentToHTML(prior, format)
+	if err == nil && normalizeHTML(priorHTML) == normalizeHTML(remote) {
+		return prior
+	}
+	return remote
+}
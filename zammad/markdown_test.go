@@ -0,0 +1,58 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import "testing"
+
+func TestMarkdownToHTML(t *testing.T) {
+	for _, tc := range []struct {
+		markdown string
+		html     string
+	}{
+		{"Hello", "<p>Hello</p>"},
+		{"Dear #{ticket.customer.firstname},\n\n**Thanks** for your request.", "<p>Dear #{ticket.customer.firstname},</p><p><strong>Thanks</strong> for your request.</p>"},
+		{"- one\n- two", "<ul><li>one</li><li>two</li></ul>"},
+		{"~~old~~", "<p><del>old</del></p>"},
+	} {
+		got, err := markdownToHTML(tc.markdown)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if normalizeHTML(got) != normalizeHTML(tc.html) {
+			t.Errorf("markdownToHTML(%q) = %q, want %q", tc.markdown, normalizeHTML(got), tc.html)
+		}
+	}
+}
+
+func TestContentFromHTML(t *testing.T) {
+	for _, tc := range []struct {
+		remote string
+		prior  string
+		format string
+		want   string
+	}{
+		{"<p>Hello <strong>World</strong></p>", "Hello **World**", contentFormatMarkdown, "Hello **World**"},
+		{"<p>Hello <strong>World</strong></p>\n", "Hello\n**World**", contentFormatMarkdown, "Hello\n**World**"},
+		{"<p>Hello World</p>", "Hello **World**", contentFormatMarkdown, "<p>Hello World</p>"},
+		{"<div>Hello</div>", "<div>\n  Hello\n</div>", contentFormatHTML, "<div>\n  Hello\n</div>"},
+		{"<div>Hello<br></div>", "<div>Hello<br/></div>", contentFormatHTML, "<div>Hello<br/></div>"},
+		{"<div>Bye</div>", "<div>Hello</div>", contentFormatHTML, "<div>Bye</div>"},
+	} {
+		if got := contentFromHTML(tc.remote, tc.prior, tc.format); got != tc.want {
+			t.Errorf("contentFromHTML(%q, %q, %q) = %q, want %q", tc.remote, tc.prior, tc.format, got, tc.want)
+		}
+	}
+}
@@ -43,9 +43,673 @@ type Organization struct {
 	Domain           types.String `tfsdk:"domain"`
 	DomainAssignment types.Bool   `tfsdk:"domain_assignment"`
 	MemberIDs        types.List   `tfsdk:"member_ids"`
+	CustomAttributes types.Map    `tfsdk:"custom_attributes"`
 	Active           types.Bool   `tfsdk:"active"`
 	CreatedByID      types.Int64  `tfsdk:"created_by_id"`
 	UpdatedByID      types.Int64  `tfsdk:"updated_by_id"`
 	CreatedAt        types.String `tfsdk:"created_at"`
 	UpdatedAt        types.String `tfsdk:"updated_at"`
 }
+
+// Calendar is a zammad calendar.
+type Calendar struct {
+	ID             types.String                     `tfsdk:"id"`
+	Name           types.String                     `tfsdk:"name"`
+	Timezone       types.String                     `tfsdk:"timezone"`
+	BusinessHours  map[string]CalendarBusinessDay   `tfsdk:"business_hours"`
+	PublicHolidays map[string]CalendarPublicHoliday `tfsdk:"public_holidays"`
+	IcalURL        types.String                     `tfsdk:"ical_url"`
+	Default        types.Bool                       `tfsdk:"default"`
+	Note           types.String                     `tfsdk:"note"`
+	CreatedByID    types.Int64                      `tfsdk:"created_by_id"`
+	UpdatedByID    types.Int64                      `tfsdk:"updated_by_id"`
+	CreatedAt      types.String                     `tfsdk:"created_at"`
+	UpdatedAt      types.String                     `tfsdk:"updated_at"`
+}
+
+// CalendarBusinessDay are the business hours of a single weekday.
+type CalendarBusinessDay struct {
+	Active     types.Bool          `tfsdk:"active"`
+	Timeframes []CalendarTimeframe `tfsdk:"timeframes"`
+}
+
+// CalendarTimeframe is a range of business hours within a day.
+type CalendarTimeframe struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+// CalendarPublicHoliday is a public holiday of a calendar.
+type CalendarPublicHoliday struct {
+	Summary types.String `tfsdk:"summary"`
+	Active  types.Bool   `tfsdk:"active"`
+}
+
+// EmailAddress is a zammad email address.
+type EmailAddress struct {
+	ID          types.String `tfsdk:"id"`
+	Realname    types.String `tfsdk:"realname"`
+	Email       types.String `tfsdk:"email"`
+	ChannelID   types.Int64  `tfsdk:"channel_id"`
+	Active      types.Bool   `tfsdk:"active"`
+	Note        types.String `tfsdk:"note"`
+	CreatedByID types.Int64  `tfsdk:"created_by_id"`
+	UpdatedByID types.Int64  `tfsdk:"updated_by_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// Signature is a zammad signature.
+type Signature struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Body        types.String `tfsdk:"body"`
+	Active      types.Bool   `tfsdk:"active"`
+	Note        types.String `tfsdk:"note"`
+	CreatedByID types.Int64  `tfsdk:"created_by_id"`
+	UpdatedByID types.Int64  `tfsdk:"updated_by_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// TextModule is a zammad text module.
+type TextModule struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Keywords      types.String `tfsdk:"keywords"`
+	Content       types.String `tfsdk:"content"`
+	ContentFormat types.String `tfsdk:"content_format"`
+	GroupIDs      types.Set    `tfsdk:"group_ids"`
+	Note          types.String `tfsdk:"note"`
+	Active        types.Bool   `tfsdk:"active"`
+	CreatedByID   types.Int64  `tfsdk:"created_by_id"`
+	UpdatedByID   types.Int64  `tfsdk:"updated_by_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// ObjectManagerAttribute is a zammad object manager attribute.
+type ObjectManagerAttribute struct {
+	ID          types.String                                       `tfsdk:"id"`
+	Object      types.String                                       `tfsdk:"object"`
+	Name        types.String                                       `tfsdk:"name"`
+	Display     types.String                                       `tfsdk:"display"`
+	DataType    types.String                                       `tfsdk:"data_type"`
+	DataOption  *ObjectManagerAttributeDataOption                  `tfsdk:"data_option"`
+	Screens     map[string]map[string]ObjectManagerAttributeScreen `tfsdk:"screens"`
+	Position    types.Int64                                        `tfsdk:"position"`
+	Active      types.Bool                                         `tfsdk:"active"`
+	Editable    types.Bool                                         `tfsdk:"editable"`
+	CreatedByID types.Int64                                        `tfsdk:"created_by_id"`
+	UpdatedByID types.Int64                                        `tfsdk:"updated_by_id"`
+	CreatedAt   types.String                                       `tfsdk:"created_at"`
+	UpdatedAt   types.String                                       `tfsdk:"updated_at"`
+}
+
+// ObjectManagerAttributeDataOption are the data type specific options of an
+// object manager attribute.
+type ObjectManagerAttributeDataOption struct {
+	Type         types.String `tfsdk:"type"`
+	Maxlength    types.Int64  `tfsdk:"maxlength"`
+	Rows         types.Int64  `tfsdk:"rows"`
+	Linktemplate types.String `tfsdk:"linktemplate"`
+	Null         types.Bool   `tfsdk:"null"`
+	Default      types.String `tfsdk:"default"`
+	Options      types.Map    `tfsdk:"options"`
+	TreeOptions  types.List   `tfsdk:"tree_options"`
+	Translate    types.Bool   `tfsdk:"translate"`
+	Nulloption   types.Bool   `tfsdk:"nulloption"`
+	Min          types.Int64  `tfsdk:"min"`
+	Max          types.Int64  `tfsdk:"max"`
+	Diff         types.Int64  `tfsdk:"diff"`
+	Future       types.Bool   `tfsdk:"future"`
+	Past         types.Bool   `tfsdk:"past"`
+}
+
+// ObjectManagerAttributeScreen is the visibility of an object manager
+// attribute in a screen.
+type ObjectManagerAttributeScreen struct {
+	Shown    types.Bool `tfsdk:"shown"`
+	Required types.Bool `tfsdk:"required"`
+}
+
+// Webhook is a zammad webhook.
+type Webhook struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	SignatureToken        types.String `tfsdk:"signature_token"`
+	SSLVerify             types.Bool   `tfsdk:"ssl_verify"`
+	BasicAuthUsername     types.String `tfsdk:"basic_auth_username"`
+	BasicAuthPassword     types.String `tfsdk:"basic_auth_password"`
+	CustomPayload         types.String `tfsdk:"custom_payload"`
+	PreDefinedWebhookType types.String `tfsdk:"pre_defined_webhook_type"`
+	Note                  types.String `tfsdk:"note"`
+	Active                types.Bool   `tfsdk:"active"`
+	CreatedByID           types.Int64  `tfsdk:"created_by_id"`
+	UpdatedByID           types.Int64  `tfsdk:"updated_by_id"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
+}
+
+// Condition is a single condition of a selector, keyed by the attribute it
+// checks.
+type Condition struct {
+	Operator     types.String   `tfsdk:"operator"`
+	Value        []types.String `tfsdk:"value"`
+	Range        types.String   `tfsdk:"range"`
+	PreCondition types.String   `tfsdk:"pre_condition"`
+}
+
+// CoreWorkflow is a zammad core workflow.
+type CoreWorkflow struct {
+	ID                types.String                   `tfsdk:"id"`
+	Name              types.String                   `tfsdk:"name"`
+	Object            types.String                   `tfsdk:"object"`
+	Preferences       *CoreWorkflowPreferences       `tfsdk:"preferences"`
+	ConditionSaved    map[string]Condition           `tfsdk:"condition_saved"`
+	ConditionSelected map[string]Condition           `tfsdk:"condition_selected"`
+	Perform           map[string]CoreWorkflowPerform `tfsdk:"perform"`
+	Priority          types.Int64                    `tfsdk:"priority"`
+	StopAfterMatch    types.Bool                     `tfsdk:"stop_after_match"`
+	Active            types.Bool                     `tfsdk:"active"`
+	CreatedByID       types.Int64                    `tfsdk:"created_by_id"`
+	UpdatedByID       types.Int64                    `tfsdk:"updated_by_id"`
+	CreatedAt         types.String                   `tfsdk:"created_at"`
+	UpdatedAt         types.String                   `tfsdk:"updated_at"`
+}
+
+// CoreWorkflowPreferences are the preferences of a core workflow.
+type CoreWorkflowPreferences struct {
+	Screen []types.String `tfsdk:"screen"`
+}
+
+// CoreWorkflowPerform is the action a core workflow performs on a field.
+type CoreWorkflowPerform struct {
+	Operators    []types.String `tfsdk:"operators"`
+	FillIn       types.String   `tfsdk:"fill_in"`
+	FillInEmpty  types.String   `tfsdk:"fill_in_empty"`
+	Select       []types.String `tfsdk:"select"`
+	SetFixedTo   []types.String `tfsdk:"set_fixed_to"`
+	AddOption    []types.String `tfsdk:"add_option"`
+	RemoveOption []types.String `tfsdk:"remove_option"`
+}
+
+// Setting is a zammad setting.
+type Setting struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ValueJSON         types.String `tfsdk:"value_json"`
+	PreviousValueJSON types.String `tfsdk:"previous_value_json"`
+	Title             types.String `tfsdk:"title"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+}
+
+// Tag is a zammad tag.
+type Tag struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Count types.Int64  `tfsdk:"count"`
+}
+
+// Tags is the list of all zammad tags.
+type Tags struct {
+	ID   types.String `tfsdk:"id"`
+	Tags []Tag        `tfsdk:"tags"`
+}
+
+// Template is a zammad ticket template.
+type Template struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Options     types.Map    `tfsdk:"options"`
+	Active      types.Bool   `tfsdk:"active"`
+	CreatedByID types.Int64  `tfsdk:"created_by_id"`
+	UpdatedByID types.Int64  `tfsdk:"updated_by_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// Ticket is a zammad ticket.
+type Ticket struct {
+	ID             types.String          `tfsdk:"id"`
+	Number         types.String          `tfsdk:"number"`
+	Title          types.String          `tfsdk:"title"`
+	GroupID        types.Int64           `tfsdk:"group_id"`
+	CustomerID     types.Int64           `tfsdk:"customer_id"`
+	OrganizationID types.Int64           `tfsdk:"organization_id"`
+	StateID        types.Int64           `tfsdk:"state_id"`
+	PriorityID     types.Int64           `tfsdk:"priority_id"`
+	OwnerID        types.Int64           `tfsdk:"owner_id"`
+	Tags           types.Set             `tfsdk:"tags"`
+	Article        *TicketInitialArticle `tfsdk:"article"`
+	CloseOnDestroy types.Bool            `tfsdk:"close_on_destroy"`
+	CreatedByID    types.Int64           `tfsdk:"created_by_id"`
+	UpdatedByID    types.Int64           `tfsdk:"updated_by_id"`
+	CreatedAt      types.String          `tfsdk:"created_at"`
+	UpdatedAt      types.String          `tfsdk:"updated_at"`
+}
+
+// TicketInitialArticle is the article a ticket is created with.
+type TicketInitialArticle struct {
+	Subject     types.String `tfsdk:"subject"`
+	Body        types.String `tfsdk:"body"`
+	Type        types.String `tfsdk:"type"`
+	ContentType types.String `tfsdk:"content_type"`
+	Internal    types.Bool   `tfsdk:"internal"`
+}
+
+// Tickets is the result of a zammad ticket search.
+type Tickets struct {
+	ID      types.String   `tfsdk:"id"`
+	Query   types.String   `tfsdk:"query"`
+	SortBy  types.String   `tfsdk:"sort_by"`
+	OrderBy types.String   `tfsdk:"order_by"`
+	Limit   types.Int64    `tfsdk:"limit"`
+	Tickets []TicketResult `tfsdk:"tickets"`
+}
+
+// TicketResult is a ticket found by a search.
+type TicketResult struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Number     types.String `tfsdk:"number"`
+	Title      types.String `tfsdk:"title"`
+	GroupID    types.Int64  `tfsdk:"group_id"`
+	CustomerID types.Int64  `tfsdk:"customer_id"`
+	StateID    types.Int64  `tfsdk:"state_id"`
+	State      types.String `tfsdk:"state"`
+	PriorityID types.Int64  `tfsdk:"priority_id"`
+	Priority   types.String `tfsdk:"priority"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// TicketArticles are the articles of a zammad ticket.
+type TicketArticles struct {
+	ID       types.String    `tfsdk:"id"`
+	TicketID types.Int64     `tfsdk:"ticket_id"`
+	Articles []TicketArticle `tfsdk:"articles"`
+}
+
+// TicketArticle is an article of a zammad ticket.
+type TicketArticle struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Subject     types.String `tfsdk:"subject"`
+	Body        types.String `tfsdk:"body"`
+	ContentType types.String `tfsdk:"content_type"`
+	Type        types.String `tfsdk:"type"`
+	Sender      types.String `tfsdk:"sender"`
+	Internal    types.Bool   `tfsdk:"internal"`
+	From        types.String `tfsdk:"from"`
+	To          types.String `tfsdk:"to"`
+	Cc          types.String `tfsdk:"cc"`
+	CreatedByID types.Int64  `tfsdk:"created_by_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// TicketLink is a link between two zammad tickets.
+type TicketLink struct {
+	ID                     types.String `tfsdk:"id"`
+	LinkType               types.String `tfsdk:"link_type"`
+	LinkObjectSourceNumber types.String `tfsdk:"link_object_source_number"`
+	LinkObjectTargetValue  types.Int64  `tfsdk:"link_object_target_value"`
+}
+
+// TimeAccountingType is a zammad time accounting activity type.
+type TimeAccountingType struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Note        types.String `tfsdk:"note"`
+	Active      types.Bool   `tfsdk:"active"`
+	CreatedByID types.Int64  `tfsdk:"created_by_id"`
+	UpdatedByID types.Int64  `tfsdk:"updated_by_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// TimeAccountingSettings are the zammad time accounting settings.
+type TimeAccountingSettings struct {
+	ID         types.String         `tfsdk:"id"`
+	Enabled    types.Bool           `tfsdk:"enabled"`
+	Selector   map[string]Condition `tfsdk:"selector"`
+	Unit       types.String         `tfsdk:"unit"`
+	UnitCustom types.String         `tfsdk:"unit_custom"`
+	Types      types.Bool           `tfsdk:"types"`
+}
+
+// ReportProfile is a zammad report profile.
+type ReportProfile struct {
+	ID          types.String         `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	Condition   map[string]Condition `tfsdk:"condition"`
+	Active      types.Bool           `tfsdk:"active"`
+	CreatedByID types.Int64          `tfsdk:"created_by_id"`
+	UpdatedByID types.Int64          `tfsdk:"updated_by_id"`
+	CreatedAt   types.String         `tfsdk:"created_at"`
+	UpdatedAt   types.String         `tfsdk:"updated_at"`
+}
+
+// KnowledgeBase is a zammad knowledge base.
+type KnowledgeBase struct {
+	ID              types.String                   `tfsdk:"id"`
+	IconSet         types.String                   `tfsdk:"iconset"`
+	ColorHighlight  types.String                   `tfsdk:"color_highlight"`
+	ColorHeader     types.String                   `tfsdk:"color_header"`
+	ColorHeaderLink types.String                   `tfsdk:"color_header_link"`
+	HomepageLayout  types.String                   `tfsdk:"homepage_layout"`
+	CategoryLayout  types.String                   `tfsdk:"category_layout"`
+	CustomAddress   types.String                   `tfsdk:"custom_address"`
+	ShowFeedIcon    types.Bool                     `tfsdk:"show_feed_icon"`
+	Active          types.Bool                     `tfsdk:"active"`
+	PrimaryLocale   types.String                   `tfsdk:"primary_locale"`
+	Locales         map[string]KnowledgeBaseLocale `tfsdk:"locales"`
+	CreatedAt       types.String                   `tfsdk:"created_at"`
+	UpdatedAt       types.String                   `tfsdk:"updated_at"`
+}
+
+// KnowledgeBaseLocale is the translation of a knowledge base into a locale.
+type KnowledgeBaseLocale struct {
+	Title      types.String `tfsdk:"title"`
+	FooterNote types.String `tfsdk:"footer_note"`
+}
+
+// KnowledgeBaseCategory is a category of a zammad knowledge base.
+type KnowledgeBaseCategory struct {
+	ID              types.String                                `tfsdk:"id"`
+	KnowledgeBaseID types.Int64                                 `tfsdk:"knowledge_base_id"`
+	ParentID        types.Int64                                 `tfsdk:"parent_id"`
+	CategoryIcon    types.String                                `tfsdk:"category_icon"`
+	Position        types.Int64                                 `tfsdk:"position"`
+	Translations    map[string]KnowledgeBaseCategoryTranslation `tfsdk:"translations"`
+	CreatedAt       types.String                                `tfsdk:"created_at"`
+	UpdatedAt       types.String                                `tfsdk:"updated_at"`
+}
+
+// KnowledgeBaseCategoryTranslation is the translation of a category into a
+// locale.
+type KnowledgeBaseCategoryTranslation struct {
+	Title types.String `tfsdk:"title"`
+}
+
+// KnowledgeBaseAnswer is an answer of a zammad knowledge base.
+type KnowledgeBaseAnswer struct {
+	ID              types.String                              `tfsdk:"id"`
+	KnowledgeBaseID types.Int64                               `tfsdk:"knowledge_base_id"`
+	CategoryID      types.Int64                               `tfsdk:"category_id"`
+	Position        types.Int64                               `tfsdk:"position"`
+	Translations    map[string]KnowledgeBaseAnswerTranslation `tfsdk:"translations"`
+	BodyFormat      types.String                              `tfsdk:"body_format"`
+	Attachments     map[string]KnowledgeBaseAnswerAttachment  `tfsdk:"attachments"`
+	PublishState    types.String                              `tfsdk:"publish_state"`
+	PublishAt       types.String                              `tfsdk:"publish_at"`
+	CreatedAt       types.String                              `tfsdk:"created_at"`
+	UpdatedAt       types.String                              `tfsdk:"updated_at"`
+}
+
+// KnowledgeBaseAnswerTranslation is the translation of an answer into a
+// locale.
+type KnowledgeBaseAnswerTranslation struct {
+	Title types.String `tfsdk:"title"`
+	Body  types.String `tfsdk:"body"`
+}
+
+// KnowledgeBaseAnswerAttachment is a local file attached to an answer.
+type KnowledgeBaseAnswerAttachment struct {
+	Path   types.String `tfsdk:"path"`
+	SHA256 types.String `tfsdk:"sha256"`
+	ID     types.Int64  `tfsdk:"id"`
+}
+
+// ChannelEmail is a zammad email channel.
+type ChannelEmail struct {
+	ID        types.String          `tfsdk:"id"`
+	Email     types.String          `tfsdk:"email"`
+	Realname  types.String          `tfsdk:"realname"`
+	GroupID   types.Int64           `tfsdk:"group_id"`
+	Inbound   *ChannelEmailInbound  `tfsdk:"inbound"`
+	Outbound  *ChannelEmailOutbound `tfsdk:"outbound"`
+	Probe     types.Bool            `tfsdk:"probe"`
+	Active    types.Bool            `tfsdk:"active"`
+	CreatedAt types.String          `tfsdk:"created_at"`
+	UpdatedAt types.String          `tfsdk:"updated_at"`
+}
+
+// ChannelEmailInbound is the account an email channel fetches mails from.
+type ChannelEmailInbound struct {
+	Adapter      types.String `tfsdk:"adapter"`
+	Host         types.String `tfsdk:"host"`
+	Port         types.Int64  `tfsdk:"port"`
+	SSL          types.String `tfsdk:"ssl"`
+	User         types.String `tfsdk:"user"`
+	Password     types.String `tfsdk:"password"`
+	Folder       types.String `tfsdk:"folder"`
+	KeepOnServer types.Bool   `tfsdk:"keep_on_server"`
+}
+
+// ChannelEmailOutbound is the account an email channel sends mails with.
+type ChannelEmailOutbound struct {
+	Adapter  types.String `tfsdk:"adapter"`
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	SSL      types.String `tfsdk:"ssl"`
+	User     types.String `tfsdk:"user"`
+	Password types.String `tfsdk:"password"`
+}
+
+// LdapSource is a zammad LDAP source.
+type LdapSource struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Host            types.String `tfsdk:"host"`
+	Port            types.Int64  `tfsdk:"port"`
+	SSL             types.String `tfsdk:"ssl"`
+	SSLVerify       types.Bool   `tfsdk:"ssl_verify"`
+	BindUser        types.String `tfsdk:"bind_user"`
+	BindPw          types.String `tfsdk:"bind_pw"`
+	BaseDN          types.String `tfsdk:"base_dn"`
+	UserFilter      types.String `tfsdk:"user_filter"`
+	UserUID         types.String `tfsdk:"user_uid"`
+	UserAttributes  types.Map    `tfsdk:"user_attributes"`
+	GroupFilter     types.String `tfsdk:"group_filter"`
+	GroupRoleMap    types.Map    `tfsdk:"group_role_map"`
+	UnassignedUsers types.String `tfsdk:"unassigned_users"`
+	Active          types.Bool   `tfsdk:"active"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+// LdapIntegration is the zammad LDAP integration setting.
+type LdapIntegration struct {
+	ID      types.String `tfsdk:"id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+// GitIntegration is the zammad GitHub or GitLab integration.
+type GitIntegration struct {
+	ID       types.String `tfsdk:"id"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Endpoint types.String `tfsdk:"endpoint"`
+	APIToken types.String `tfsdk:"api_token"`
+}
+
+// SlackIntegration is the zammad Slack integration.
+type SlackIntegration struct {
+	ID      types.String           `tfsdk:"id"`
+	Enabled types.Bool             `tfsdk:"enabled"`
+	Items   []SlackIntegrationItem `tfsdk:"items"`
+}
+
+// SlackIntegrationItem is a Slack webhook notified about ticket events.
+type SlackIntegrationItem struct {
+	Webhook  types.String `tfsdk:"webhook"`
+	Username types.String `tfsdk:"username"`
+	Channel  types.String `tfsdk:"channel"`
+	IconURL  types.String `tfsdk:"icon_url"`
+	GroupIDs types.Set    `tfsdk:"group_ids"`
+	Types    types.Set    `tfsdk:"types"`
+}
+
+// SMIMECertificate is a zammad S/MIME certificate with its private key.
+type SMIMECertificate struct {
+	ID               types.String `tfsdk:"id"`
+	Certificate      types.String `tfsdk:"certificate"`
+	PrivateKey       types.String `tfsdk:"private_key"`
+	PrivateKeySecret types.String `tfsdk:"private_key_secret"`
+	Fingerprint      types.String `tfsdk:"fingerprint"`
+	Subject          types.String `tfsdk:"subject"`
+	EmailAddresses   types.List   `tfsdk:"email_addresses"`
+	NotBefore        types.String `tfsdk:"not_before"`
+	NotAfter         types.String `tfsdk:"not_after"`
+	HasPrivateKey    types.Bool   `tfsdk:"has_private_key"`
+}
+
+// SMIMECertificates are the zammad S/MIME certificates.
+type SMIMECertificates struct {
+	ID                types.String           `tfsdk:"id"`
+	ExpiryWarningDays types.Int64            `tfsdk:"expiry_warning_days"`
+	Certificates      []SMIMECertificateInfo `tfsdk:"certificates"`
+}
+
+// SMIMECertificateInfo is a certificate of the zammad_smime_certificates data
+// source.
+type SMIMECertificateInfo struct {
+	ID             types.String `tfsdk:"id"`
+	Fingerprint    types.String `tfsdk:"fingerprint"`
+	Subject        types.String `tfsdk:"subject"`
+	EmailAddresses types.List   `tfsdk:"email_addresses"`
+	NotBefore      types.String `tfsdk:"not_before"`
+	NotAfter       types.String `tfsdk:"not_after"`
+	HasPrivateKey  types.Bool   `tfsdk:"has_private_key"`
+}
+
+// PGPKey is a zammad PGP key.
+type PGPKey struct {
+	ID             types.String `tfsdk:"id"`
+	Key            types.String `tfsdk:"key"`
+	Passphrase     types.String `tfsdk:"passphrase"`
+	DomainAlias    types.String `tfsdk:"domain_alias"`
+	Fingerprint    types.String `tfsdk:"fingerprint"`
+	Name           types.String `tfsdk:"name"`
+	EmailAddresses types.List   `tfsdk:"email_addresses"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	Secret         types.Bool   `tfsdk:"secret"`
+}
+
+// PGPKeys are the zammad PGP keys.
+type PGPKeys struct {
+	ID                types.String `tfsdk:"id"`
+	ExpiryWarningDays types.Int64  `tfsdk:"expiry_warning_days"`
+	Keys              []PGPKeyInfo `tfsdk:"keys"`
+}
+
+// PGPKeyInfo is a key of the zammad_pgp_keys data source.
+type PGPKeyInfo struct {
+	ID             types.String `tfsdk:"id"`
+	Fingerprint    types.String `tfsdk:"fingerprint"`
+	Name           types.String `tfsdk:"name"`
+	EmailAddresses types.List   `tfsdk:"email_addresses"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	Secret         types.Bool   `tfsdk:"secret"`
+	DomainAlias    types.String `tfsdk:"domain_alias"`
+}
+
+// UserAccessToken is a zammad API token of the authenticated user.
+type UserAccessToken struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	Token       types.String `tfsdk:"token"`
+	LastUsedAt  types.String `tfsdk:"last_used_at"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// UserGroupAccess is the access of a zammad user to a group.
+type UserGroupAccess struct {
+	ID      types.String `tfsdk:"id"`
+	UserID  types.Int64  `tfsdk:"user_id"`
+	GroupID types.Int64  `tfsdk:"group_id"`
+	Access  types.Set    `tfsdk:"access"`
+}
+
+// Users are the zammad users found by a search.
+type Users struct {
+	ID             types.String `tfsdk:"id"`
+	Query          types.String `tfsdk:"query"`
+	RoleIDs        types.Set    `tfsdk:"role_ids"`
+	OrganizationID types.Int64  `tfsdk:"organization_id"`
+	Active         types.Bool   `tfsdk:"active"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	Users          []UserResult `tfsdk:"users"`
+}
+
+// UserResult is a zammad user, looked up by the zammad_user data source or
+// found by a search.
+type UserResult struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Login          types.String `tfsdk:"login"`
+	Email          types.String `tfsdk:"email"`
+	Firstname      types.String `tfsdk:"firstname"`
+	Lastname       types.String `tfsdk:"lastname"`
+	OrganizationID types.Int64  `tfsdk:"organization_id"`
+	Active         types.Bool   `tfsdk:"active"`
+	VIP            types.Bool   `tfsdk:"vip"`
+	RoleIDs        types.Set    `tfsdk:"role_ids"`
+	GroupIDs       types.Map    `tfsdk:"group_ids"`
+}
+
+// Groups are all zammad groups.
+type Groups struct {
+	ID     types.String  `tfsdk:"id"`
+	Groups []GroupResult `tfsdk:"groups"`
+}
+
+// GroupResult is a zammad group, looked up by the zammad_group data source or
+// listed by zammad_groups.
+type GroupResult struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	EmailAddressID     types.Int64  `tfsdk:"email_address_id"`
+	SignatureID        types.Int64  `tfsdk:"signature_id"`
+	AssignmentTimeout  types.Int64  `tfsdk:"assignment_timeout"`
+	FollowUpPossible   types.String `tfsdk:"follow_up_possible"`
+	FollowUpAssignment types.Bool   `tfsdk:"follow_up_assignment"`
+	Note               types.String `tfsdk:"note"`
+	Active             types.Bool   `tfsdk:"active"`
+}
+
+// Roles are all zammad roles.
+type Roles struct {
+	ID    types.String `tfsdk:"id"`
+	Roles []RoleResult `tfsdk:"roles"`
+}
+
+// RoleResult is a zammad role, looked up by the zammad_role data source or
+// listed by zammad_roles.
+type RoleResult struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	DefaultAtSignup types.Bool   `tfsdk:"default_at_signup"`
+	Note            types.String `tfsdk:"note"`
+	Active          types.Bool   `tfsdk:"active"`
+}
+
+// TicketStates are all zammad ticket states.
+type TicketStates struct {
+	ID           types.String        `tfsdk:"id"`
+	TicketStates []TicketStateResult `tfsdk:"ticket_states"`
+}
+
+// TicketStateResult is a zammad ticket state, looked up by the
+// zammad_ticket_state data source or listed by zammad_ticket_states.
+type TicketStateResult struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	StateTypeID      types.Int64  `tfsdk:"state_type_id"`
+	NextStateID      types.Int64  `tfsdk:"next_state_id"`
+	IgnoreEscalation types.Bool   `tfsdk:"ignore_escalation"`
+	DefaultCreate    types.Bool   `tfsdk:"default_create"`
+	DefaultFollowUp  types.Bool   `tfsdk:"default_follow_up"`
+	Note             types.String `tfsdk:"note"`
+	Active           types.Bool   `tfsdk:"active"`
+}
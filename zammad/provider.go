@@ -147,9 +147,51 @@ func (p *provider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewZammadTicketPriority,
 		NewZammadOrganization,
+		NewZammadCalendar,
+		NewZammadEmailAddress,
+		NewZammadSignature,
+		NewZammadTextModule,
+		NewZammadObjectManagerAttribute,
+		NewZammadWebhook,
+		NewZammadCoreWorkflow,
+		NewZammadSetting,
+		NewZammadTag,
+		NewZammadTemplate,
+		NewZammadTicket,
+		NewZammadTicketLink,
+		NewZammadTimeAccountingType,
+		NewZammadTimeAccountingSettings,
+		NewZammadReportProfile,
+		NewZammadKnowledgeBase,
+		NewZammadKnowledgeBaseCategory,
+		NewZammadKnowledgeBaseAnswer,
+		NewZammadChannelEmail,
+		NewZammadLdapSource,
+		NewZammadLdapIntegration,
+		NewZammadIntegrationGitHub,
+		NewZammadIntegrationGitLab,
+		NewZammadIntegrationSlack,
+		NewZammadSMIMECertificate,
+		NewZammadPGPKey,
+		NewZammadUserAccessToken,
+		NewZammadUserGroupAccess,
 	}
 }
 
 func (p *provider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewZammadTagsDataSource,
+		NewZammadTicketsDataSource,
+		NewZammadTicketArticlesDataSource,
+		NewZammadSMIMECertificatesDataSource,
+		NewZammadPGPKeysDataSource,
+		NewZammadUserDataSource,
+		NewZammadUsersDataSource,
+		NewZammadGroupDataSource,
+		NewZammadGroupsDataSource,
+		NewZammadRoleDataSource,
+		NewZammadRolesDataSource,
+		NewZammadTicketStateDataSource,
+		NewZammadTicketStatesDataSource,
+	}
 }
@@ -0,0 +1,417 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// calendarWeekdays are the keys Zammad uses for the business hours.
+var calendarWeekdays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+func NewZammadCalendar() resource.Resource {
+	return &resourceCalendar{}
+}
+
+type resourceCalendar struct {
+	client *client.Client
+}
+
+// Calendar Resource schema
+func (r resourceCalendar) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"timezone": schema.StringAttribute{
+				Required:    true,
+				Description: "Timezone of the calendar, e.g. Europe/Berlin.",
+				Validators:  []validator.String{timezoneValidator{}},
+			},
+			"business_hours": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Business hours per weekday (mon, tue, wed, thu, fri, sat, sun). Weekdays which are not configured are closed.",
+				Validators: []validator.Map{mapKeysMatchValidator{
+					re:      regexp.MustCompile(`^(mon|tue|wed|thu|fri|sat|sun)$`),
+					message: "one of mon, tue, wed, thu, fri, sat or sun",
+				}},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"active": schema.BoolAttribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.Bool{&defaultTrue{}},
+						},
+						"timeframes": schema.ListNestedAttribute{
+							Required: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"from": schema.StringAttribute{
+										Required:    true,
+										Description: "Start of the timeframe, in HH:MM format.",
+										Validators:  []validator.String{timeOfDayValidator},
+									},
+									"to": schema.StringAttribute{
+										Required:    true,
+										Description: "End of the timeframe, in HH:MM format.",
+										Validators:  []validator.String{timeOfDayValidator},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"public_holidays": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Public holidays, keyed by date in YYYY-MM-DD format. Holidays imported from the iCalendar feed are not tracked.",
+				Validators: []validator.Map{mapKeysMatchValidator{
+					re:      regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+					message: "a date in YYYY-MM-DD format",
+				}},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"summary": schema.StringAttribute{
+							Required: true,
+						},
+						"active": schema.BoolAttribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.Bool{&defaultTrue{}},
+						},
+					},
+				},
+			},
+			"ical_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of an iCalendar feed to import public holidays from.",
+			},
+			"default": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Use this calendar for SLAs without a calendar.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"note": schema.StringAttribute{
+				Optional: true,
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+var timeOfDayValidator = stringMatchValidator{
+	re:      regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`),
+	message: "a time in HH:MM format",
+}
+
+func (r *resourceCalendar) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_calendar"
+}
+
+func (r *resourceCalendar) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceCalendar) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan Calendar
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calreq := &client.Calendar{
+		Name:           plan.Name.ValueString(),
+		Timezone:       plan.Timezone.ValueString(),
+		BusinessHours:  calendarBusinessHoursToClient(plan.BusinessHours),
+		PublicHolidays: calendarPublicHolidaysToClient(plan.PublicHolidays),
+		IcalURL:        plan.IcalURL.ValueString(),
+		Default:        plan.Default.ValueBool(),
+		Note:           plan.Note.ValueString(),
+	}
+
+	cal, err := r.client.CreateCalendar(calreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating calendar",
+			"Could not create calendar, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, calendarFromClient(cal, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceCalendar) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Calendar
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	cal, err := r.client.GetCalendar(calID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading calendar",
+			"Could not read calendar "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, calendarFromClient(cal, state))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceCalendar) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Calendar
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state Calendar
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	updatedCal := &client.Calendar{
+		ID:             calID,
+		Name:           plan.Name.ValueString(),
+		Timezone:       plan.Timezone.ValueString(),
+		BusinessHours:  calendarBusinessHoursToClient(plan.BusinessHours),
+		PublicHolidays: calendarPublicHolidaysToClient(plan.PublicHolidays),
+		IcalURL:        plan.IcalURL.ValueString(),
+		Default:        plan.Default.ValueBool(),
+		Note:           plan.Note.ValueString(),
+	}
+
+	cal, err := r.client.UpdateCalendar(updatedCal)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating calendar",
+			"Could not update calendar "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, calendarFromClient(cal, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceCalendar) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Calendar
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteCalendar(&client.Calendar{ID: calID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting calendar",
+			"Could not delete calendar "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceCalendar) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// calendarBusinessHoursToClient returns the business hours of all weekdays,
+// Zammad expects every weekday to be present.
+func calendarBusinessHoursToClient(hours map[string]CalendarBusinessDay) map[string]client.CalendarBusinessDay {
+	result := make(map[string]client.CalendarBusinessDay, len(calendarWeekdays))
+	for _, day := range calendarWeekdays {
+		bd, ok := hours[day]
+		if !ok {
+			result[day] = client.CalendarBusinessDay{
+				Active:     false,
+				Timeframes: [][]string{{"09:00", "17:00"}},
+			}
+			continue
+		}
+		timeframes := make([][]string, len(bd.Timeframes))
+		for i, tf := range bd.Timeframes {
+			timeframes[i] = []string{tf.From.ValueString(), tf.To.ValueString()}
+		}
+		result[day] = client.CalendarBusinessDay{
+			Active:     bd.Active.ValueBool(),
+			Timeframes: timeframes,
+		}
+	}
+	return result
+}
+
+func calendarPublicHolidaysToClient(holidays map[string]CalendarPublicHoliday) map[string]client.CalendarPublicHoliday {
+	result := make(map[string]client.CalendarPublicHoliday, len(holidays))
+	for date, h := range holidays {
+		result[date] = client.CalendarPublicHoliday{
+			Summary: h.Summary.ValueString(),
+			Active:  h.Active.ValueBool(),
+		}
+	}
+	return result
+}
+
+// calendarFromClient converts a calendar returned by Zammad. Weekdays and
+// holidays which are not part of prior are only reported if they would change
+// the behaviour of the calendar, so that the padding done on write and the
+// holidays imported from the iCalendar feed do not show up as drift.
+func calendarFromClient(cal *client.Calendar, prior Calendar) Calendar {
+	result := Calendar{
+		ID:          types.StringValue(strconv.Itoa(cal.ID)),
+		Name:        types.StringValue(cal.Name),
+		Timezone:    types.StringValue(cal.Timezone),
+		IcalURL:     types.StringValue(cal.IcalURL),
+		Default:     types.BoolValue(cal.Default),
+		Note:        types.StringValue(cal.Note),
+		CreatedByID: types.Int64Value(int64(cal.CreatedByID)),
+		UpdatedByID: types.Int64Value(int64(cal.UpdatedByID)),
+		CreatedAt:   types.StringValue(cal.CreatedAt),
+		UpdatedAt:   types.StringValue(cal.UpdatedAt),
+	}
+	if prior.IcalURL.IsNull() && cal.IcalURL == "" {
+		result.IcalURL = types.StringNull()
+	}
+	if prior.Note.IsNull() && cal.Note == "" {
+		result.Note = types.StringNull()
+	}
+
+	result.BusinessHours = make(map[string]CalendarBusinessDay)
+	for day, bd := range cal.BusinessHours {
+		if _, ok := prior.BusinessHours[day]; !ok && !bd.Active {
+			continue
+		}
+		timeframes := make([]CalendarTimeframe, 0, len(bd.Timeframes))
+		for _, tf := range bd.Timeframes {
+			if len(tf) != 2 {
+				continue
+			}
+			timeframes = append(timeframes, CalendarTimeframe{
+				From: types.StringValue(tf[0]),
+				To:   types.StringValue(tf[1]),
+			})
+		}
+		result.BusinessHours[day] = CalendarBusinessDay{
+			Active:     types.BoolValue(bd.Active),
+			Timeframes: timeframes,
+		}
+	}
+
+	if prior.PublicHolidays != nil {
+		result.PublicHolidays = make(map[string]CalendarPublicHoliday)
+	}
+	for date, h := range cal.PublicHolidays {
+		if _, ok := prior.PublicHolidays[date]; !ok && h.Feed != "" {
+			continue
+		}
+		if result.PublicHolidays == nil {
+			result.PublicHolidays = make(map[string]CalendarPublicHoliday)
+		}
+		result.PublicHolidays[date] = CalendarPublicHoliday{
+			Summary: types.StringValue(h.Summary),
+			Active:  types.BoolValue(h.Active),
+		}
+	}
+
+	return result
+}
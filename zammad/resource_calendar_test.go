@@ -0,0 +1,146 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceCalendar{}
+
+func TestAccBasicCalendarResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCalendarResourceConfig("one", "Europe/Berlin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_calendar.test", "name", "one"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "timezone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "business_hours.%", "1"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "business_hours.mon.active", "true"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "business_hours.mon.timeframes.0.from", "09:00"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_calendar.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCalendarResourceConfig("two", "America/New_York"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_calendar.test", "name", "two"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "timezone", "America/New_York"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdvancedCalendarResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAdvancedCalendarResourceConfig("one", "2030-12-25", "Christmas Day", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_calendar.test", "name", "one"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "business_hours.%", "2"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "business_hours.mon.timeframes.#", "2"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "business_hours.sat.active", "false"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "public_holidays.2030-12-25.summary", "Christmas Day"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "public_holidays.2030-12-25.active", "true"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "note", "Support hours"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccAdvancedCalendarResourceConfig("one", "2030-12-26", "Boxing Day", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_calendar.test", "public_holidays.%", "1"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "public_holidays.2030-12-26.summary", "Boxing Day"),
+					resource.TestCheckResourceAttr("zammad_calendar.test", "public_holidays.2030-12-26.active", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInvalidTimezoneCalendarResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCalendarResourceConfig("one", "Europe/Nowhere"),
+				ExpectError: regexp.MustCompile("Invalid timezone"),
+			},
+		},
+	})
+}
+
+func testAccCalendarResourceConfig(name, timezone string) string {
+	return fmt.Sprintf(`
+resource "zammad_calendar" "test" {
+	name = "%s"
+	timezone = "%s"
+	business_hours = {
+		mon = {
+			timeframes = [{ from = "09:00", to = "17:00" }]
+		}
+	}
+}
+`, name, timezone)
+}
+
+func testAccAdvancedCalendarResourceConfig(name, holiday, summary, active string) string {
+	return fmt.Sprintf(`
+resource "zammad_calendar" "test" {
+	name = "%s"
+	timezone = "Europe/Brussels"
+	note = "Support hours"
+	business_hours = {
+		mon = {
+			timeframes = [
+				{ from = "08:00", to = "12:00" },
+				{ from = "13:00", to = "17:00" },
+			]
+		}
+		sat = {
+			active = false
+			timeframes = [{ from = "10:00", to = "12:00" }]
+		}
+	}
+	public_holidays = {
+		"%s" = {
+			summary = "%s"
+			active = %s
+		}
+	}
+}
+`, name, holiday, summary, active)
+}
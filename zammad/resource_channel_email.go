@@ -0,0 +1,550 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+const (
+	emailSSL      = "ssl"
+	emailStartTLS = "starttls"
+	emailSSLOff   = "off"
+)
+
+func NewZammadChannelEmail() resource.Resource {
+	return &resourceChannelEmail{}
+}
+
+type resourceChannelEmail struct {
+	client *client.Client
+}
+
+// ChannelEmail Resource schema
+func (r resourceChannelEmail) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	sslModes := []validator.String{stringOneOfValidator{values: []string{emailSSL, emailStartTLS, emailSSLOff}}}
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "Address the channel sends mails from. Zammad creates the email address when the channel is created.",
+			},
+			"realname": schema.StringAttribute{
+				Required:    true,
+				Description: "Display name of the sender.",
+			},
+			"group_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Group of the tickets created from incoming mails.",
+			},
+			"inbound": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Account the mails are fetched from.",
+				Attributes: map[string]schema.Attribute{
+					"adapter": schema.StringAttribute{
+						Required:    true,
+						Description: "imap or pop3.",
+						Validators:  []validator.String{stringOneOfValidator{values: []string{"imap", "pop3"}}},
+					},
+					"host": schema.StringAttribute{
+						Required: true,
+					},
+					"port": schema.Int64Attribute{
+						Optional: true,
+					},
+					"ssl": schema.StringAttribute{
+						Optional:    true,
+						Description: "ssl, starttls or off.",
+						Validators:  sslModes,
+					},
+					"user": schema.StringAttribute{
+						Required: true,
+					},
+					"password": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+					"folder": schema.StringAttribute{
+						Optional:    true,
+						Description: "IMAP folder to fetch, the inbox if not set.",
+					},
+					"keep_on_server": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Keep fetched mails on the server, they are deleted otherwise. Only supported for IMAP.",
+					},
+				},
+			},
+			"outbound": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Account the mails are sent with.",
+				Attributes: map[string]schema.Attribute{
+					"adapter": schema.StringAttribute{
+						Required:    true,
+						Description: "smtp or sendmail.",
+						Validators:  []validator.String{stringOneOfValidator{values: []string{"smtp", "sendmail"}}},
+					},
+					"host": schema.StringAttribute{
+						Optional: true,
+					},
+					"port": schema.Int64Attribute{
+						Optional: true,
+					},
+					"ssl": schema.StringAttribute{
+						Optional:    true,
+						Description: "ssl, starttls (default) or off.",
+						Validators:  sslModes,
+					},
+					"user": schema.StringAttribute{
+						Optional: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+			},
+			"probe": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Run the inbound and outbound probes of Zammad before saving changed accounts, so that wrong settings are reported per account. Defaults to true. Disabling the probes does not skip the verification: Zammad only saves the accounts after a test mail was delivered from the outbound to the inbound account, which can take a few minutes.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceChannelEmail) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_email"
+}
+
+func (r *resourceChannelEmail) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceChannelEmail) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ChannelEmail
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	verifyreq := channelEmailVerifyToClient(plan)
+	if !r.probe(verifyreq, plan, resp.Diagnostics.AddAttributeError) {
+		return
+	}
+
+	ch, err := r.client.VerifyEmailChannel(verifyreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating email channel",
+			"Could not create email channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !plan.Active.ValueBool() {
+		err = r.client.SetEmailChannelActive(ch.ID, false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating email channel",
+				"Could not disable email channel "+strconv.Itoa(ch.ID)+": "+err.Error(),
+			)
+			return
+		}
+		ch.Active = false
+	}
+
+	diags = resp.State.Set(ctx, channelEmailFromClient(ch, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceChannelEmail) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ChannelEmail
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	ch, err := r.client.GetEmailChannel(chID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email channel",
+			"Could not read email channel "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	result := channelEmailFromClient(ch, state)
+	if result.Email.IsNull() {
+		// Imported channels, the sender is the email address of the channel.
+		addresses, err := r.client.GetEmailAddresses()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading email channel",
+				"Could not read email addresses: "+err.Error(),
+			)
+			return
+		}
+		for _, ea := range addresses {
+			if ea.ChannelID != nil && *ea.ChannelID == ch.ID {
+				result.Email = types.StringValue(ea.Email)
+				result.Realname = types.StringValue(ea.Realname)
+			}
+		}
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceChannelEmail) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ChannelEmail
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state ChannelEmail
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only changed accounts are verified again, which takes a while and
+	// sends a test mail.
+	accountsChanged := !plan.Email.Equal(state.Email) || !plan.Realname.Equal(state.Realname) ||
+		*plan.Inbound != *state.Inbound || *plan.Outbound != *state.Outbound
+	if accountsChanged {
+		verifyreq := channelEmailVerifyToClient(plan)
+		verifyreq.ChannelID = chID
+		if !r.probe(verifyreq, plan, resp.Diagnostics.AddAttributeError) {
+			return
+		}
+		_, err = r.client.VerifyEmailChannel(verifyreq)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating email channel",
+				"Could not update email channel "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	} else if !plan.GroupID.Equal(state.GroupID) {
+		err = r.client.SetEmailChannelGroup(chID, int(plan.GroupID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating email channel",
+				"Could not set group of email channel "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Active.Equal(state.Active) {
+		err = r.client.SetEmailChannelActive(chID, plan.Active.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating email channel",
+				"Could not change active flag of email channel "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	ch, err := r.client.GetEmailChannel(chID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating email channel",
+			"Could not read email channel "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, channelEmailFromClient(ch, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceChannelEmail) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ChannelEmail
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteEmailChannel(&client.ChannelEmail{ID: chID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting email channel",
+			"Could not delete email channel "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceChannelEmail) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// probe runs the inbound and outbound probes unless they are disabled. It
+// returns false if one of them failed.
+func (r resourceChannelEmail) probe(verifyreq *client.ChannelEmailVerify, plan ChannelEmail, addError func(path.Path, string, string)) bool {
+	if !plan.Probe.IsNull() && !plan.Probe.ValueBool() {
+		return true
+	}
+	ok := true
+	if err := r.client.ProbeEmailInbound(verifyreq.Inbound); err != nil {
+		addError(path.Root("inbound"), "Inbound probe failed", "Zammad could not fetch mails from "+plan.Inbound.Host.ValueString()+": "+err.Error())
+		ok = false
+	}
+	if err := r.client.ProbeEmailOutbound(verifyreq.Outbound, plan.Email.ValueString()); err != nil {
+		addError(path.Root("outbound"), "Outbound probe failed", "Zammad could not send mails as "+plan.Email.ValueString()+": "+err.Error())
+		ok = false
+	}
+	return ok
+}
+
+func channelEmailVerifyToClient(plan ChannelEmail) *client.ChannelEmailVerify {
+	inbound := client.ChannelEmailAccount{
+		Adapter: plan.Inbound.Adapter.ValueString(),
+		Options: client.ChannelEmailAccountOptions{
+			Host:     plan.Inbound.Host.ValueString(),
+			User:     plan.Inbound.User.ValueString(),
+			Password: plan.Inbound.Password.ValueString(),
+			Folder:   plan.Inbound.Folder.ValueString(),
+		},
+	}
+	if !plan.Inbound.Port.IsNull() {
+		inbound.Options.Port = json.Number(strconv.FormatInt(plan.Inbound.Port.ValueInt64(), 10))
+	}
+	if !plan.Inbound.SSL.IsNull() {
+		inbound.Options.SSL = plan.Inbound.SSL.ValueString()
+	}
+	if !plan.Inbound.KeepOnServer.IsNull() {
+		keep := plan.Inbound.KeepOnServer.ValueBool()
+		inbound.Options.KeepOnServer = &keep
+	}
+
+	outbound := client.ChannelEmailAccount{
+		Adapter: plan.Outbound.Adapter.ValueString(),
+		Options: client.ChannelEmailAccountOptions{
+			Host:     plan.Outbound.Host.ValueString(),
+			User:     plan.Outbound.User.ValueString(),
+			Password: plan.Outbound.Password.ValueString(),
+		},
+	}
+	if !plan.Outbound.Port.IsNull() {
+		outbound.Options.Port = json.Number(strconv.FormatInt(plan.Outbound.Port.ValueInt64(), 10))
+	}
+	if !plan.Outbound.SSL.IsNull() {
+		ssl := plan.Outbound.SSL.ValueString() == emailSSL
+		startTLS := plan.Outbound.SSL.ValueString() == emailStartTLS
+		outbound.Options.SSL = ssl
+		outbound.Options.EnableStartTLSAuto = &startTLS
+	}
+
+	return &client.ChannelEmailVerify{
+		GroupID:  int(plan.GroupID.ValueInt64()),
+		Inbound:  inbound,
+		Outbound: outbound,
+		Meta: client.ChannelEmailVerifyMeta{
+			Email:    plan.Email.ValueString(),
+			Realname: plan.Realname.ValueString(),
+		},
+	}
+}
+
+// emailPortValue returns the port of an account, Zammad stores the ports as
+// sent by the client, either as number or as string.
+func emailPortValue(port json.Number, prior types.Int64) types.Int64 {
+	p, err := port.Int64()
+	if err != nil {
+		return prior
+	}
+	return types.Int64Value(p)
+}
+
+// channelEmailFromClient returns the state of a channel. Email, realname and
+// the passwords are not part of the channel in Zammad, they are kept from
+// prior.
+func channelEmailFromClient(ch *client.ChannelEmail, prior ChannelEmail) ChannelEmail {
+	in := ch.Options.Inbound.Options
+	inbound := &ChannelEmailInbound{
+		Adapter:      types.StringValue(ch.Options.Inbound.Adapter),
+		Host:         types.StringValue(in.Host),
+		Port:         types.Int64Null(),
+		SSL:          types.StringNull(),
+		User:         types.StringValue(in.User),
+		Password:     types.StringNull(),
+		Folder:       types.StringNull(),
+		KeepOnServer: types.BoolNull(),
+	}
+	priorInbound := prior.Inbound
+	if priorInbound == nil {
+		priorInbound = &ChannelEmailInbound{}
+	}
+	if in.Port != "" {
+		inbound.Port = emailPortValue(in.Port, priorInbound.Port)
+	}
+	switch ssl := in.SSL.(type) {
+	case string:
+		if ssl != "" {
+			inbound.SSL = types.StringValue(ssl)
+		}
+	case bool:
+		// Channels created by older Zammad versions only know SSL on or off.
+		inbound.SSL = types.StringValue(emailSSLOff)
+		if ssl {
+			inbound.SSL = types.StringValue(emailSSL)
+		}
+	}
+	if !priorInbound.Password.IsNull() {
+		inbound.Password = priorInbound.Password
+	} else if in.Password != "" {
+		inbound.Password = types.StringValue(in.Password)
+	}
+	if in.Folder != "" {
+		inbound.Folder = types.StringValue(in.Folder)
+	}
+	if in.KeepOnServer != nil && (*in.KeepOnServer || !priorInbound.KeepOnServer.IsNull()) {
+		inbound.KeepOnServer = types.BoolValue(*in.KeepOnServer)
+	}
+
+	out := ch.Options.Outbound.Options
+	outbound := &ChannelEmailOutbound{
+		Adapter:  types.StringValue(ch.Options.Outbound.Adapter),
+		Host:     types.StringNull(),
+		Port:     types.Int64Null(),
+		SSL:      types.StringNull(),
+		User:     types.StringNull(),
+		Password: types.StringNull(),
+	}
+	priorOutbound := prior.Outbound
+	if priorOutbound == nil {
+		priorOutbound = &ChannelEmailOutbound{}
+	}
+	if out.Host != "" {
+		outbound.Host = types.StringValue(out.Host)
+	}
+	if out.Port != "" {
+		outbound.Port = emailPortValue(out.Port, priorOutbound.Port)
+	}
+	if out.User != "" {
+		outbound.User = types.StringValue(out.User)
+	}
+	if !priorOutbound.Password.IsNull() {
+		outbound.Password = priorOutbound.Password
+	} else if out.Password != "" {
+		outbound.Password = types.StringValue(out.Password)
+	}
+	ssl := emailStartTLS
+	if b, _ := out.SSL.(bool); b {
+		ssl = emailSSL
+	} else if out.EnableStartTLSAuto != nil && !*out.EnableStartTLSAuto {
+		ssl = emailSSLOff
+	}
+	if ssl != emailStartTLS || !priorOutbound.SSL.IsNull() {
+		outbound.SSL = types.StringValue(ssl)
+	}
+
+	return ChannelEmail{
+		ID:        types.StringValue(strconv.Itoa(ch.ID)),
+		Email:     prior.Email,
+		Realname:  prior.Realname,
+		GroupID:   types.Int64Value(int64(ch.GroupID)),
+		Inbound:   inbound,
+		Outbound:  outbound,
+		Probe:     prior.Probe,
+		Active:    types.BoolValue(ch.Active),
+		CreatedAt: types.StringValue(ch.CreatedAt),
+		UpdatedAt: types.StringValue(ch.UpdatedAt),
+	}
+}
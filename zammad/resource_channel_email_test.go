@@ -0,0 +1,100 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceChannelEmail{}
+
+// TestAccBasicChannelEmailResource needs a mailbox which Zammad can fetch
+// from and send with, given by ZAMMAD_TEST_MAIL_HOST, ZAMMAD_TEST_MAIL_USER,
+// ZAMMAD_TEST_MAIL_PASSWORD and ZAMMAD_TEST_MAIL_ADDRESS.
+func TestAccBasicChannelEmailResource(t *testing.T) {
+	host := os.Getenv("ZAMMAD_TEST_MAIL_HOST")
+	if host == "" {
+		t.Skip("ZAMMAD_TEST_MAIL_HOST is not set")
+	}
+	user := os.Getenv("ZAMMAD_TEST_MAIL_USER")
+	password := os.Getenv("ZAMMAD_TEST_MAIL_PASSWORD")
+	address := os.Getenv("ZAMMAD_TEST_MAIL_ADDRESS")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccChannelEmailResourceConfig(host, user, password, address, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_channel_email.test", "inbound.adapter", "imap"),
+					resource.TestCheckResourceAttr("zammad_channel_email.test", "inbound.host", host),
+					resource.TestCheckResourceAttr("zammad_channel_email.test", "inbound.keep_on_server", "true"),
+					resource.TestCheckResourceAttr("zammad_channel_email.test", "outbound.adapter", "smtp"),
+					resource.TestCheckResourceAttr("zammad_channel_email.test", "group_id", "1"),
+					resource.TestCheckResourceAttr("zammad_channel_email.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "zammad_channel_email.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inbound.password", "outbound.password", "probe"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccChannelEmailResourceConfig(host, user, password, address, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_channel_email.test", "active", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccChannelEmailResourceConfig(host, user, password, address string, active bool) string {
+	return fmt.Sprintf(`
+resource "zammad_channel_email" "test" {
+	email    = %[4]q
+	realname = "Support"
+	group_id = 1
+	active   = %[5]t
+
+	inbound = {
+		adapter        = "imap"
+		host           = %[1]q
+		ssl            = "ssl"
+		user           = %[2]q
+		password       = %[3]q
+		keep_on_server = true
+	}
+
+	outbound = {
+		adapter  = "smtp"
+		host     = %[1]q
+		user     = %[2]q
+		password = %[3]q
+	}
+}
+`, host, user, password, address, active)
+}
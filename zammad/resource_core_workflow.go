@@ -0,0 +1,454 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// coreWorkflowFlagOperators are the perform operators without a value.
+var coreWorkflowFlagOperators = []string{
+	"show", "hide", "remove", "set_mandatory", "set_optional", "set_readonly", "unset_readonly", "auto_select",
+}
+
+// coreWorkflowValueOperators are the perform operators which take their value
+// from the attribute of the same name.
+var coreWorkflowValueOperators = []string{
+	"fill_in", "fill_in_empty", "select", "set_fixed_to", "add_option", "remove_option",
+}
+
+func NewZammadCoreWorkflow() resource.Resource {
+	return &resourceCoreWorkflow{}
+}
+
+type resourceCoreWorkflow struct {
+	client *client.Client
+}
+
+// CoreWorkflow Resource schema
+func (r resourceCoreWorkflow) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"object": schema.StringAttribute{
+				Required:    true,
+				Description: "Object whose forms the workflow applies to: Ticket, User, Organization or Group.",
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{"Ticket", "User", "Organization", "Group"},
+				}},
+			},
+			"preferences": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"screen": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Screens the workflow applies to, e.g. create_middle or edit. Applies to all screens if not set.",
+					},
+				},
+			},
+			"condition_saved":    conditionSchema("Conditions on the saved object, keyed by attribute.", false),
+			"condition_selected": conditionSchema("Conditions on the values currently selected in the form, keyed by attribute.", false),
+			"perform": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Actions to perform on the fields of the form, keyed by attribute, e.g. ticket.priority_id.",
+				Validators:  []validator.Map{attributeKeyValidator},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"operators": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "Operators to apply: show, hide, remove, set_mandatory, set_optional, set_readonly, unset_readonly, auto_select, fill_in, fill_in_empty, select, set_fixed_to, add_option or remove_option.",
+						},
+						"fill_in": schema.StringAttribute{
+							Optional:    true,
+							Description: "Value of the fill_in operator.",
+						},
+						"fill_in_empty": schema.StringAttribute{
+							Optional:    true,
+							Description: "Value of the fill_in_empty operator.",
+						},
+						"select": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Values of the select operator.",
+						},
+						"set_fixed_to": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Values of the set_fixed_to operator.",
+						},
+						"add_option": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Values of the add_option operator.",
+						},
+						"remove_option": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Values of the remove_option operator.",
+						},
+					},
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Workflows are executed in ascending order of priority.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"stop_after_match": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Do not execute further workflows if this one matches.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceCoreWorkflow) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_core_workflow"
+}
+
+func (r *resourceCoreWorkflow) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig checks the operators of perform and that the operators which
+// need a value have one.
+func (r resourceCoreWorkflow) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var perform types.Map
+	diags := req.Config.GetAttribute(ctx, path.Root("perform"), &perform)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || perform.IsNull() || perform.IsUnknown() {
+		return
+	}
+
+	for field, elem := range perform.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		attrs := obj.Attributes()
+		operators, ok := attrs["operators"].(types.List)
+		if !ok || operators.IsNull() || operators.IsUnknown() {
+			continue
+		}
+		for _, op := range operators.Elements() {
+			opValue, ok := op.(types.String)
+			if !ok || opValue.IsNull() || opValue.IsUnknown() {
+				continue
+			}
+			p := path.Root("perform").AtMapKey(field).AtName("operators")
+			name := opValue.ValueString()
+			switch {
+			case stringInSlice(name, coreWorkflowFlagOperators):
+			case stringInSlice(name, coreWorkflowValueOperators):
+				if value := attrs[name]; value == nil || value.IsNull() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("perform").AtMapKey(field).AtName(name),
+						"Missing operator value",
+						fmt.Sprintf("%s is required for the %s operator.", name, name),
+					)
+				}
+			default:
+				resp.Diagnostics.AddAttributeError(p, "Invalid operator", fmt.Sprintf("%q is not a known operator.", name))
+			}
+		}
+	}
+}
+
+// Create a new resource
+func (r resourceCoreWorkflow) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan CoreWorkflow
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cw, err := r.client.CreateCoreWorkflow(coreWorkflowToClient(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating core workflow",
+			"Could not create core workflow, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, coreWorkflowFromClient(cw, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceCoreWorkflow) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CoreWorkflow
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cwID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	cw, err := r.client.GetCoreWorkflow(cwID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading core workflow",
+			"Could not read core workflow "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, coreWorkflowFromClient(cw, state))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceCoreWorkflow) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CoreWorkflow
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state CoreWorkflow
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cwID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	updatedCW := coreWorkflowToClient(plan)
+	updatedCW.ID = cwID
+
+	cw, err := r.client.UpdateCoreWorkflow(updatedCW)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating core workflow",
+			"Could not update core workflow "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, coreWorkflowFromClient(cw, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceCoreWorkflow) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CoreWorkflow
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cwID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteCoreWorkflow(&client.CoreWorkflow{ID: cwID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting core workflow",
+			"Could not delete core workflow "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceCoreWorkflow) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func coreWorkflowToClient(plan CoreWorkflow) *client.CoreWorkflow {
+	cw := &client.CoreWorkflow{
+		Name:              plan.Name.ValueString(),
+		Object:            plan.Object.ValueString(),
+		Preferences:       map[string]interface{}{},
+		ConditionSaved:    conditionsToClient(plan.ConditionSaved),
+		ConditionSelected: conditionsToClient(plan.ConditionSelected),
+		Perform:           make(map[string]map[string]interface{}, len(plan.Perform)),
+		Priority:          int(plan.Priority.ValueInt64()),
+		StopAfterMatch:    plan.StopAfterMatch.ValueBool(),
+		Changeable:        true,
+		Active:            plan.Active.ValueBool(),
+	}
+	if plan.Preferences != nil && plan.Preferences.Screen != nil {
+		cw.Preferences["screen"] = stringsFromValues(plan.Preferences.Screen)
+	}
+
+	for field, p := range plan.Perform {
+		operators := stringsFromValues(p.Operators)
+		item := map[string]interface{}{
+			"operator": operators,
+		}
+		for _, op := range operators {
+			if stringInSlice(op, coreWorkflowFlagOperators) {
+				item[op] = "true"
+			}
+		}
+		if !p.FillIn.IsNull() {
+			item["fill_in"] = p.FillIn.ValueString()
+		}
+		if !p.FillInEmpty.IsNull() {
+			item["fill_in_empty"] = p.FillInEmpty.ValueString()
+		}
+		if p.Select != nil {
+			item["select"] = stringsFromValues(p.Select)
+		}
+		if p.SetFixedTo != nil {
+			item["set_fixed_to"] = stringsFromValues(p.SetFixedTo)
+		}
+		if p.AddOption != nil {
+			item["add_option"] = stringsFromValues(p.AddOption)
+		}
+		if p.RemoveOption != nil {
+			item["remove_option"] = stringsFromValues(p.RemoveOption)
+		}
+		cw.Perform[field] = item
+	}
+
+	return cw
+}
+
+func coreWorkflowFromClient(cw *client.CoreWorkflow, prior CoreWorkflow) CoreWorkflow {
+	result := CoreWorkflow{
+		ID:                types.StringValue(strconv.Itoa(cw.ID)),
+		Name:              types.StringValue(cw.Name),
+		Object:            types.StringValue(cw.Object),
+		ConditionSaved:    conditionsFromClient(cw.ConditionSaved),
+		ConditionSelected: conditionsFromClient(cw.ConditionSelected),
+		Perform:           make(map[string]CoreWorkflowPerform, len(cw.Perform)),
+		Priority:          types.Int64Value(int64(cw.Priority)),
+		StopAfterMatch:    types.BoolValue(cw.StopAfterMatch),
+		Active:            types.BoolValue(cw.Active),
+		CreatedByID:       types.Int64Value(int64(cw.CreatedByID)),
+		UpdatedByID:       types.Int64Value(int64(cw.UpdatedByID)),
+		CreatedAt:         types.StringValue(cw.CreatedAt),
+		UpdatedAt:         types.StringValue(cw.UpdatedAt),
+	}
+	if prior.ConditionSaved != nil && result.ConditionSaved == nil {
+		result.ConditionSaved = map[string]Condition{}
+	}
+	if prior.ConditionSelected != nil && result.ConditionSelected == nil {
+		result.ConditionSelected = map[string]Condition{}
+	}
+
+	if screen := stringValuesFromJSON(cw.Preferences["screen"]); screen != nil {
+		result.Preferences = &CoreWorkflowPreferences{Screen: screen}
+	} else if prior.Preferences != nil {
+		result.Preferences = &CoreWorkflowPreferences{}
+	}
+
+	for field, item := range cw.Perform {
+		p := CoreWorkflowPerform{
+			Operators:    stringValuesFromJSON(item["operator"]),
+			FillIn:       types.StringNull(),
+			FillInEmpty:  types.StringNull(),
+			Select:       stringValuesFromJSON(item["select"]),
+			SetFixedTo:   stringValuesFromJSON(item["set_fixed_to"]),
+			AddOption:    stringValuesFromJSON(item["add_option"]),
+			RemoveOption: stringValuesFromJSON(item["remove_option"]),
+		}
+		if v, ok := item["fill_in"]; ok && v != nil {
+			p.FillIn = types.StringValue(jsonScalarString(v))
+		}
+		if v, ok := item["fill_in_empty"]; ok && v != nil {
+			p.FillInEmpty = types.StringValue(jsonScalarString(v))
+		}
+		result.Perform[field] = p
+	}
+
+	return result
+}
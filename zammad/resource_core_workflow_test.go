@@ -0,0 +1,102 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceCoreWorkflow{}
+
+func TestAccBasicCoreWorkflowResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCoreWorkflowResourceConfig("one", `operators = ["set_mandatory", "set_fixed_to"]
+			set_fixed_to = ["2", "3"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_core_workflow.test", "name", "one"),
+					resource.TestCheckResourceAttr("zammad_core_workflow.test", "object", "Ticket"),
+					resource.TestCheckResourceAttr("zammad_core_workflow.test", "condition_selected.ticket.state_id.value.0", "1"),
+					resource.TestCheckResourceAttr("zammad_core_workflow.test", "perform.ticket.priority_id.operators.#", "2"),
+					resource.TestCheckResourceAttr("zammad_core_workflow.test", "perform.ticket.priority_id.set_fixed_to.#", "2"),
+					resource.TestCheckResourceAttr("zammad_core_workflow.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "zammad_core_workflow.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"condition_saved"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCoreWorkflowResourceConfig("two", `operators = ["hide"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_core_workflow.test", "name", "two"),
+					resource.TestCheckResourceAttr("zammad_core_workflow.test", "perform.ticket.priority_id.operators.0", "hide"),
+					resource.TestCheckNoResourceAttr("zammad_core_workflow.test", "perform.ticket.priority_id.set_fixed_to"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInvalidPerformCoreWorkflowResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoreWorkflowResourceConfig("one", `operators = ["select"]`),
+				ExpectError: regexp.MustCompile("Missing operator value"),
+			},
+			{
+				Config:      testAccCoreWorkflowResourceConfig("one", `operators = ["shout"]`),
+				ExpectError: regexp.MustCompile("Invalid operator"),
+			},
+		},
+	})
+}
+
+func testAccCoreWorkflowResourceConfig(name, perform string) string {
+	return fmt.Sprintf(`
+resource "zammad_core_workflow" "test" {
+	name   = "%s"
+	object = "Ticket"
+	condition_saved = {}
+	condition_selected = {
+		"ticket.state_id" = {
+			operator = "is"
+			value    = ["1"]
+		}
+	}
+	perform = {
+		"ticket.priority_id" = {
+			%s
+		}
+	}
+}
+`, name, perform)
+}
@@ -0,0 +1,335 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadEmailAddress() resource.Resource {
+	return &resourceEmailAddress{}
+}
+
+type resourceEmailAddress struct {
+	client *client.Client
+}
+
+// Email Address Resource schema
+func (r resourceEmailAddress) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"realname": schema.StringAttribute{
+				Required:    true,
+				Description: "Display name used as sender.",
+			},
+			"email": schema.StringAttribute{
+				Required: true,
+			},
+			"channel_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the email channel used to send emails from this address.",
+			},
+			"note": schema.StringAttribute{
+				Optional: true,
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Zammad deactivates email addresses which are not assigned to a channel, so active can only be true together with channel_id. Defaults to true with a channel and false without.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceEmailAddress) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_address"
+}
+
+func (r *resourceEmailAddress) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig rejects active addresses without a channel, Zammad would
+// deactivate them.
+func (r resourceEmailAddress) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EmailAddress
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Active.ValueBool() && config.ChannelID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("active"),
+			"Missing channel",
+			"Zammad deactivates email addresses which are not assigned to a channel, set channel_id or active = false.",
+		)
+	}
+}
+
+// ModifyPlan plans addresses with a channel as active and without a channel
+// as inactive, unless active is set.
+func (r resourceEmailAddress) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan EmailAddress
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var active types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("active"), &active)...)
+	if resp.Diagnostics.HasError() || !active.IsNull() {
+		return
+	}
+	// A channel_id which is not known yet is still set, so the address is
+	// active once it is created or assigned.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("active"), types.BoolValue(!plan.ChannelID.IsNull()))...)
+}
+
+// Create a new resource
+func (r resourceEmailAddress) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan EmailAddress
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eareq := &client.EmailAddress{
+		Realname:  plan.Realname.ValueString(),
+		Email:     plan.Email.ValueString(),
+		ChannelID: int64PointerValue(plan.ChannelID),
+		Note:      plan.Note.ValueString(),
+		Active:    plan.Active.ValueBool(),
+	}
+
+	ea, err := r.client.CreateEmailAddress(eareq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating email_address",
+			"Could not create email_address, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := EmailAddress{
+		ID:          types.StringValue(strconv.Itoa(ea.ID)),
+		Realname:    types.StringValue(ea.Realname),
+		Email:       types.StringValue(ea.Email),
+		ChannelID:   int64PointerToValue(ea.ChannelID),
+		Note:        types.StringValue(ea.Note),
+		Active:      types.BoolValue(ea.Active),
+		CreatedByID: types.Int64Value(int64(ea.CreatedByID)),
+		UpdatedByID: types.Int64Value(int64(ea.UpdatedByID)),
+		CreatedAt:   types.StringValue(ea.CreatedAt),
+		UpdatedAt:   types.StringValue(ea.UpdatedAt),
+	}
+	if plan.Note.IsNull() && ea.Note == "" {
+		result.Note = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceEmailAddress) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EmailAddress
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eaID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	newea, err := r.client.GetEmailAddress(eaID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email_address",
+			"Could not read email_address "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Realname = types.StringValue(newea.Realname)
+	state.Email = types.StringValue(newea.Email)
+	state.ChannelID = int64PointerToValue(newea.ChannelID)
+	state.Active = types.BoolValue(newea.Active)
+	state.UpdatedAt = types.StringValue(newea.UpdatedAt)
+	state.UpdatedByID = types.Int64Value(int64(newea.UpdatedByID))
+	state.CreatedAt = types.StringValue(newea.CreatedAt)
+	state.CreatedByID = types.Int64Value(int64(newea.CreatedByID))
+	if state.Note.IsNull() && newea.Note == "" {
+		state.Note = types.StringNull()
+	} else {
+		state.Note = types.StringValue(newea.Note)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceEmailAddress) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EmailAddress
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state EmailAddress
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eaID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	updatedEA := &client.EmailAddress{
+		ID:        eaID,
+		Realname:  plan.Realname.ValueString(),
+		Email:     plan.Email.ValueString(),
+		ChannelID: int64PointerValue(plan.ChannelID),
+		Note:      plan.Note.ValueString(),
+		Active:    plan.Active.ValueBool(),
+	}
+
+	ea, err := r.client.UpdateEmailAddress(updatedEA)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating email_address",
+			"Could not update email_address "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	result := EmailAddress{
+		ID:          types.StringValue(strconv.Itoa(ea.ID)),
+		Realname:    types.StringValue(ea.Realname),
+		Email:       types.StringValue(ea.Email),
+		ChannelID:   int64PointerToValue(ea.ChannelID),
+		Note:        types.StringValue(ea.Note),
+		Active:      types.BoolValue(ea.Active),
+		CreatedByID: types.Int64Value(int64(ea.CreatedByID)),
+		UpdatedByID: types.Int64Value(int64(ea.UpdatedByID)),
+		CreatedAt:   types.StringValue(ea.CreatedAt),
+		UpdatedAt:   types.StringValue(ea.UpdatedAt),
+	}
+	if plan.Note.IsNull() && ea.Note == "" {
+		result.Note = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceEmailAddress) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EmailAddress
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eaID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteEmailAddress(&client.EmailAddress{ID: eaID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting email_address",
+			"Could not delete email_address "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceEmailAddress) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// int64PointerValue returns nil for a null value, so that the attribute is
+// sent as null to Zammad.
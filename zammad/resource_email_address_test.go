@@ -0,0 +1,137 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceEmailAddress{}
+
+func TestAccBasicEmailAddressResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "zammad_email_address" "test" {
+	realname = "Support"
+	email    = "support@example.com"
+	active   = true
+}
+`,
+				ExpectError: regexp.MustCompile("Missing channel"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccEmailAddressResourceConfig("Support", "support@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_email_address.test", "realname", "Support"),
+					resource.TestCheckResourceAttr("zammad_email_address.test", "email", "support@example.com"),
+					resource.TestCheckNoResourceAttr("zammad_email_address.test", "channel_id"),
+					resource.TestCheckResourceAttr("zammad_email_address.test", "active", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_email_address.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccEmailAddressResourceConfig("Helpdesk", "helpdesk@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_email_address.test", "realname", "Helpdesk"),
+					resource.TestCheckResourceAttr("zammad_email_address.test", "email", "helpdesk@example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEmailAddressResourceConfig(realname, email string) string {
+	return fmt.Sprintf(`
+resource "zammad_email_address" "test" {
+	realname = "%s"
+	email = "%s"
+}
+`, realname, email)
+}
+
+// TestAccChannelEmailAddressResource needs the mailbox of
+// TestAccBasicChannelEmailResource.
+func TestAccChannelEmailAddressResource(t *testing.T) {
+	host := os.Getenv("ZAMMAD_TEST_MAIL_HOST")
+	if host == "" {
+		t.Skip("ZAMMAD_TEST_MAIL_HOST is not set")
+	}
+	user := os.Getenv("ZAMMAD_TEST_MAIL_USER")
+	password := os.Getenv("ZAMMAD_TEST_MAIL_PASSWORD")
+	address := os.Getenv("ZAMMAD_TEST_MAIL_ADDRESS")
+	channel := testAccChannelEmailResourceConfig(host, user, password, address, true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The channel is created in the same apply, so channel_id is
+			// unknown when the address is planned.
+			{
+				Config: channel + testAccChannelEmailAddressResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("zammad_email_address.test", "channel_id", "zammad_channel_email.test", "id"),
+					resource.TestCheckResourceAttr("zammad_email_address.test", "active", "true"),
+				),
+			},
+			{
+				Config: channel + testAccChannelEmailAddressResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("zammad_email_address.test", "channel_id"),
+					resource.TestCheckResourceAttr("zammad_email_address.test", "active", "false"),
+				),
+			},
+			// Assigning a channel activates the address again.
+			{
+				Config: channel + testAccChannelEmailAddressResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("zammad_email_address.test", "channel_id", "zammad_channel_email.test", "id"),
+					resource.TestCheckResourceAttr("zammad_email_address.test", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccChannelEmailAddressResourceConfig(withChannel bool) string {
+	channelID := ""
+	if withChannel {
+		channelID = "channel_id = zammad_channel_email.test.id"
+	}
+	return fmt.Sprintf(`
+resource "zammad_email_address" "test" {
+	realname = "Support Alias"
+	email    = "support-alias@example.com"
+	%s
+}
+`, channelID)
+}
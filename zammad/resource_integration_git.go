@@ -0,0 +1,233 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// gitIntegrationConfig is the value of the github_config and gitlab_config
+// settings.
+type gitIntegrationConfig struct {
+	Endpoint string `json:"endpoint"`
+	APIToken string `json:"api_token"`
+}
+
+func NewZammadIntegrationGitHub() resource.Resource {
+	return &resourceGitIntegration{
+		name:            "github",
+		title:           "GitHub",
+		defaultEndpoint: "https://api.github.com/graphql",
+	}
+}
+
+func NewZammadIntegrationGitLab() resource.Resource {
+	return &resourceGitIntegration{
+		name:            "gitlab",
+		title:           "GitLab",
+		defaultEndpoint: "https://gitlab.com/api/graphql",
+	}
+}
+
+// resourceGitIntegration manages the GitHub and GitLab integrations, which
+// only differ by their settings.
+type resourceGitIntegration struct {
+	client          *client.Client
+	name            string
+	title           string
+	defaultEndpoint string
+}
+
+// GitIntegration Resource schema
+func (r resourceGitIntegration) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Link " + r.title + " issues to tickets.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable the integration. Defaults to true, the integration is disabled on destroy.",
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"endpoint": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "GraphQL endpoint of " + r.title + ", defaults to " + r.defaultEndpoint + ".",
+				Validators:    []validator.String{urlValidator{}},
+				PlanModifiers: []planmodifier.String{defaultString{value: r.defaultEndpoint}},
+			},
+			"api_token": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "API token used to read the issues.",
+			},
+		},
+	}
+}
+
+func (r *resourceGitIntegration) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_" + r.name
+}
+
+func (r *resourceGitIntegration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create enables the integration
+func (r resourceGitIntegration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan GitIntegration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceGitIntegration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GitIntegration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSettingsByName(r.settingNames()...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading "+r.title+" integration",
+			"Could not read "+r.title+" settings: "+err.Error(),
+		)
+		return
+	}
+
+	result, diags := r.fromClient(settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceGitIntegration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GitIntegration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete disables the integration and removes the token
+func (r resourceGitIntegration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	_, diags := r.apply(GitIntegration{
+		Enabled:  types.BoolValue(false),
+		Endpoint: types.StringValue(r.defaultEndpoint),
+		APIToken: types.StringValue(""),
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Import resource
+func (r resourceGitIntegration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r resourceGitIntegration) settingNames() []string {
+	return []string{r.name + "_integration", r.name + "_config"}
+}
+
+// apply writes plan to the settings and returns the new state.
+func (r resourceGitIntegration) apply(plan GitIntegration) (GitIntegration, diag.Diagnostics) {
+	// The config is written first, so the integration is not enabled with
+	// the previous config if it fails.
+	settings, diags := updateSettings(r.client, r.title+" integration",
+		settingValue{r.name + "_config", gitIntegrationConfig{
+			Endpoint: plan.Endpoint.ValueString(),
+			APIToken: plan.APIToken.ValueString(),
+		}},
+		settingValue{r.name + "_integration", plan.Enabled.ValueBool()},
+	)
+	if diags.HasError() {
+		return plan, diags
+	}
+	return r.fromClient(settings)
+}
+
+func (r resourceGitIntegration) fromClient(settings map[string]*client.Setting) (GitIntegration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var enabled bool
+	var config gitIntegrationConfig
+	decodeSetting(settings[r.name+"_integration"], &enabled, &diags)
+	decodeSetting(settings[r.name+"_config"], &config, &diags)
+	if config.Endpoint == "" {
+		config.Endpoint = r.defaultEndpoint
+	}
+	return GitIntegration{
+		ID:       types.StringValue(r.name + "_integration"),
+		Enabled:  types.BoolValue(enabled),
+		Endpoint: types.StringValue(config.Endpoint),
+		APIToken: types.StringValue(config.APIToken),
+	}, diags
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceGitIntegration{}
+
+func TestAccBasicGitIntegrationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGitIntegrationResourceConfig("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_integration_github.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("zammad_integration_github.test", "endpoint", "https://api.github.com/graphql"),
+					resource.TestCheckResourceAttr("zammad_integration_github.test", "api_token", "one"),
+					resource.TestCheckResourceAttr("zammad_integration_gitlab.test", "endpoint", "https://gitlab.example.com/api/graphql"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_integration_github.test",
+				ImportState:       true,
+				ImportStateId:     "github_integration",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGitIntegrationResourceConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_integration_github.test", "api_token", "two"),
+					resource.TestCheckResourceAttr("zammad_integration_gitlab.test", "api_token", "two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGitIntegrationResourceConfig(token string) string {
+	return fmt.Sprintf(`
+resource "zammad_integration_github" "test" {
+	api_token = "%[1]s"
+}
+
+resource "zammad_integration_gitlab" "test" {
+	endpoint  = "https://gitlab.example.com/api/graphql"
+	api_token = "%[1]s"
+}
+`, token)
+}
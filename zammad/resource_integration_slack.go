@@ -0,0 +1,318 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+const slackIntegrationID = "slack_integration"
+
+// slackEventTypes are the ticket events Slack can be notified about.
+var slackEventTypes = []string{"create", "update", "reminder_reached", "escalation", "escalation_warning"}
+
+// slackConfig is the value of the slack_config setting.
+type slackConfig struct {
+	Items []slackConfigItem `json:"items"`
+}
+
+type slackConfigItem struct {
+	Webhook  string     `json:"webhook"`
+	Username string     `json:"username"`
+	Channel  string     `json:"channel"`
+	IconURL  string     `json:"icon_url"`
+	GroupIDs client.IDs `json:"group_ids"`
+	Types    []string   `json:"types"`
+}
+
+func NewZammadIntegrationSlack() resource.Resource {
+	return &resourceSlackIntegration{}
+}
+
+type resourceSlackIntegration struct {
+	client *client.Client
+}
+
+// SlackIntegration Resource schema
+func (r resourceSlackIntegration) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Notify Slack channels about ticket events. Zammad has no integration setting for Microsoft Teams, Teams channels are notified with a `zammad_webhook` with `pre_defined_webhook_type = \"MicrosoftTeams\"` instead, the same as Mattermost and Rocket.Chat.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable the integration. Defaults to true, the integration is disabled and its webhooks are removed on destroy.",
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"items": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Webhooks to notify.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"webhook": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "URL of the incoming webhook.",
+							Validators:  []validator.String{urlValidator{}},
+						},
+						"username": schema.StringAttribute{
+							Required:    true,
+							Description: "Name the messages are posted as.",
+						},
+						"channel": schema.StringAttribute{
+							Optional:    true,
+							Description: "Channel to post to, the channel of the webhook if not set.",
+						},
+						"icon_url": schema.StringAttribute{
+							Optional:   true,
+							Validators: []validator.String{urlValidator{}},
+						},
+						"group_ids": schema.SetAttribute{
+							ElementType: types.Int64Type,
+							Required:    true,
+							Description: "Groups of the tickets to notify about.",
+						},
+						"types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Ticket events to notify about: create, update, reminder_reached, escalation and escalation_warning.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackIntegration) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_slack"
+}
+
+func (r *resourceSlackIntegration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig checks the groups and event types of the items.
+func (r resourceSlackIntegration) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var items []SlackIntegrationItem
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("items"), &items)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, item := range items {
+		itemPath := path.Root("items").AtListIndex(i)
+		if !item.GroupIDs.IsUnknown() && len(item.GroupIDs.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(itemPath.AtName("group_ids"), "Missing groups", "Slack is only notified about tickets of the given groups, at least one is required.")
+		}
+		if item.Types.IsUnknown() {
+			continue
+		}
+		if len(item.Types.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(itemPath.AtName("types"), "Missing event types", "At least one event type is required.")
+		}
+		var eventTypes []types.String
+		resp.Diagnostics.Append(item.Types.ElementsAs(ctx, &eventTypes, true)...)
+		for _, t := range eventTypes {
+			if !t.IsUnknown() && !stringInSlice(t.ValueString(), slackEventTypes) {
+				resp.Diagnostics.AddAttributeError(itemPath.AtName("types"), "Invalid event type", fmt.Sprintf("%q is not one of %v.", t.ValueString(), slackEventTypes))
+			}
+		}
+	}
+}
+
+// Create enables the integration
+func (r resourceSlackIntegration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan SlackIntegration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceSlackIntegration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SlackIntegration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSettingsByName("slack_integration", "slack_config")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Slack integration",
+			"Could not read Slack settings: "+err.Error(),
+		)
+		return
+	}
+
+	result, diags := slackIntegrationFromClient(settings, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceSlackIntegration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SlackIntegration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete disables the integration and removes the webhooks
+func (r resourceSlackIntegration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	_, diags := r.apply(ctx, SlackIntegration{
+		Enabled: types.BoolValue(false),
+		Items:   []SlackIntegrationItem{},
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Import resource
+func (r resourceSlackIntegration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes plan to the settings and returns the new state.
+func (r resourceSlackIntegration) apply(ctx context.Context, plan SlackIntegration) (SlackIntegration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := slackConfig{Items: make([]slackConfigItem, 0, len(plan.Items))}
+	for _, item := range plan.Items {
+		var groupIDs []int
+		var eventTypes []string
+		diags.Append(item.GroupIDs.ElementsAs(ctx, &groupIDs, false)...)
+		diags.Append(item.Types.ElementsAs(ctx, &eventTypes, false)...)
+		sort.Ints(groupIDs)
+		sort.Strings(eventTypes)
+		config.Items = append(config.Items, slackConfigItem{
+			Webhook:  item.Webhook.ValueString(),
+			Username: item.Username.ValueString(),
+			Channel:  item.Channel.ValueString(),
+			IconURL:  item.IconURL.ValueString(),
+			GroupIDs: groupIDs,
+			Types:    eventTypes,
+		})
+	}
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	settings, diags := updateSettings(r.client, "Slack integration",
+		settingValue{"slack_config", config},
+		settingValue{"slack_integration", plan.Enabled.ValueBool()},
+	)
+	if diags.HasError() {
+		return plan, diags
+	}
+	return slackIntegrationFromClient(settings, plan)
+}
+
+func slackIntegrationFromClient(settings map[string]*client.Setting, prior SlackIntegration) (SlackIntegration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var enabled bool
+	var config slackConfig
+	decodeSetting(settings["slack_integration"], &enabled, &diags)
+	decodeSetting(settings["slack_config"], &config, &diags)
+
+	result := SlackIntegration{
+		ID:      types.StringValue(slackIntegrationID),
+		Enabled: types.BoolValue(enabled),
+		Items:   make([]SlackIntegrationItem, 0, len(config.Items)),
+	}
+	for i, item := range config.Items {
+		var priorItem SlackIntegrationItem
+		if i < len(prior.Items) {
+			priorItem = prior.Items[i]
+		}
+		eventTypes := make([]attr.Value, len(item.Types))
+		for j, t := range item.Types {
+			eventTypes[j] = types.StringValue(t)
+		}
+		resultItem := SlackIntegrationItem{
+			Webhook:  types.StringValue(item.Webhook),
+			Username: types.StringValue(item.Username),
+			Channel:  types.StringValue(item.Channel),
+			IconURL:  types.StringValue(item.IconURL),
+			GroupIDs: int64SetValue(item.GroupIDs),
+			Types:    types.SetValueMust(types.StringType, eventTypes),
+		}
+		if priorItem.Channel.IsNull() && item.Channel == "" {
+			resultItem.Channel = types.StringNull()
+		}
+		if priorItem.IconURL.IsNull() && item.IconURL == "" {
+			resultItem.IconURL = types.StringNull()
+		}
+		result.Items = append(result.Items, resultItem)
+	}
+	return result, diags
+}
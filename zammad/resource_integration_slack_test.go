@@ -0,0 +1,82 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceSlackIntegration{}
+
+func TestAccBasicSlackIntegrationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSlackIntegrationResourceConfig("support", `"create", "closed"`),
+				ExpectError: regexp.MustCompile("Invalid event type"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSlackIntegrationResourceConfig("support", `"create", "update"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_integration_slack.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("zammad_integration_slack.test", "items.0.username", "Zammad"),
+					resource.TestCheckResourceAttr("zammad_integration_slack.test", "items.0.channel", "support"),
+					resource.TestCheckResourceAttr("zammad_integration_slack.test", "items.0.group_ids.#", "1"),
+					resource.TestCheckResourceAttr("zammad_integration_slack.test", "items.0.types.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_integration_slack.test",
+				ImportState:       true,
+				ImportStateId:     "slack_integration",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSlackIntegrationResourceConfig("escalations", `"escalation"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_integration_slack.test", "items.0.channel", "escalations"),
+					resource.TestCheckResourceAttr("zammad_integration_slack.test", "items.0.types.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSlackIntegrationResourceConfig(channel, eventTypes string) string {
+	return fmt.Sprintf(`
+resource "zammad_integration_slack" "test" {
+	items = [
+		{
+			webhook   = "https://hooks.slack.com/services/T0000/B0000/XXXX"
+			username  = "Zammad"
+			channel   = "%s"
+			group_ids = [1]
+			types     = [%s]
+		},
+	]
+}
+`, channel, eventTypes)
+}
@@ -0,0 +1,558 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// knowledgeBaseDefaults are the values of the Zammad knowledge base editor for
+// attributes which are not configured.
+var knowledgeBaseDefaults = client.KnowledgeBase{
+	IconSet:         "FontAwesome",
+	ColorHighlight:  "#38ae6a",
+	ColorHeader:     "#f9fafb",
+	ColorHeaderLink: "hsl(206,8%,50%)",
+	HomepageLayout:  "grid",
+	CategoryLayout:  "grid",
+}
+
+var localeKeyValidator = mapKeysMatchValidator{
+	re:      regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]+)*$`),
+	message: "a locale such as en-us",
+}
+
+func NewZammadKnowledgeBase() resource.Resource {
+	return &resourceKnowledgeBase{}
+}
+
+type resourceKnowledgeBase struct {
+	client *client.Client
+}
+
+// KnowledgeBase Resource schema
+func (r resourceKnowledgeBase) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	layouts := []validator.String{stringOneOfValidator{values: []string{"grid", "list"}}}
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"iconset": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Icon set of the categories, e.g. FontAwesome or anticon.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"color_highlight": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"color_header": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"color_header_link": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"homepage_layout": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Layout of the homepage, grid or list.",
+				Validators:    layouts,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"category_layout": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Layout of the categories, grid or list.",
+				Validators:    layouts,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"custom_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Custom address the public knowledge base is served at, e.g. help.example.com or /help.",
+			},
+			"show_feed_icon": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Show a link to the RSS feed of the public answers.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"primary_locale": schema.StringAttribute{
+				Required:    true,
+				Description: "Primary locale of the knowledge base, one of the keys of locales.",
+			},
+			"locales": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Locales of the knowledge base, keyed by system locale, e.g. en-us.",
+				Validators:  []validator.Map{localeKeyValidator},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							Required: true,
+						},
+						"footer_note": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceKnowledgeBase) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_base"
+}
+
+func (r *resourceKnowledgeBase) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig checks that the primary locale is one of the locales.
+func (r resourceKnowledgeBase) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var primary types.String
+	var locales types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("primary_locale"), &primary)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("locales"), &locales)...)
+	if resp.Diagnostics.HasError() || primary.IsNull() || primary.IsUnknown() || locales.IsNull() || locales.IsUnknown() {
+		return
+	}
+	if _, ok := locales.Elements()[primary.ValueString()]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary_locale"),
+			"Invalid primary locale",
+			fmt.Sprintf("%q is not one of the locales of the knowledge base.", primary.ValueString()),
+		)
+	}
+}
+
+// Create a new resource
+func (r resourceKnowledgeBase) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan KnowledgeBase
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	systemLocales, err := r.systemLocaleIDs()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating knowledge base",
+			"Could not read locales: "+err.Error(),
+		)
+		return
+	}
+
+	kbreq := knowledgeBaseToClient(plan)
+	kbreq.Locales, diags = knowledgeBaseLocalesToClient(plan, nil, systemLocales)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kb, err := r.client.CreateKnowledgeBase(kbreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating knowledge base",
+			"Could not create knowledge base, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Translations refer to the locales, which only exist now.
+	kb.Translations = knowledgeBaseTranslationsToClient(plan, kb, systemLocales)
+	kb.Locales = nil
+	kb, err = r.client.UpdateKnowledgeBase(kb)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating knowledge base",
+			"Could not set translations of knowledge base: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, knowledgeBaseFromClient(kb, plan, systemLocales))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceKnowledgeBase) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state KnowledgeBase
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kbID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	kb, err := r.client.GetKnowledgeBase(kbID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading knowledge base",
+			"Could not read knowledge base "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	systemLocales, err := r.systemLocaleIDs()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading knowledge base",
+			"Could not read locales: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, knowledgeBaseFromClient(kb, state, systemLocales))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceKnowledgeBase) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan KnowledgeBase
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state KnowledgeBase
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kbID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	current, err := r.client.GetKnowledgeBase(kbID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base",
+			"Could not read knowledge base "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	systemLocales, err := r.systemLocaleIDs()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base",
+			"Could not read locales: "+err.Error(),
+		)
+		return
+	}
+
+	updatedKB := knowledgeBaseToClient(plan)
+	updatedKB.ID = kbID
+	updatedKB.Locales, diags = knowledgeBaseLocalesToClient(plan, current.Locales, systemLocales)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kb, err := r.client.UpdateKnowledgeBase(updatedKB)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base",
+			"Could not update knowledge base "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	kb.Translations = knowledgeBaseTranslationsToClient(plan, kb, systemLocales)
+	kb.Locales = nil
+	kb, err = r.client.UpdateKnowledgeBase(kb)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base",
+			"Could not update translations of knowledge base "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, knowledgeBaseFromClient(kb, plan, systemLocales))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceKnowledgeBase) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state KnowledgeBase
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kbID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteKnowledgeBase(&client.KnowledgeBase{ID: kbID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting knowledge base",
+			"Could not delete knowledge base "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceKnowledgeBase) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// systemLocaleIDs returns the IDs of the system locales, keyed by locale.
+func (r resourceKnowledgeBase) systemLocaleIDs() (map[string]int, error) {
+	return systemLocaleIDs(r.client)
+}
+
+func systemLocaleIDs(c *client.Client) (map[string]int, error) {
+	locales, err := c.GetLocales()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]int, len(locales))
+	for _, l := range locales {
+		result[l.Locale] = l.ID
+	}
+	return result, nil
+}
+
+// knowledgeBaseLocaleIDs returns the IDs of the locales of a knowledge base,
+// keyed by locale. Translations of categories and answers refer to them.
+func knowledgeBaseLocaleIDs(c *client.Client, kbID int) (map[string]int, error) {
+	kb, err := c.GetKnowledgeBase(kbID)
+	if err != nil {
+		return nil, err
+	}
+	systemLocales, err := systemLocaleIDs(c)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]int, len(kb.Locales))
+	for code, systemID := range systemLocales {
+		for _, l := range kb.Locales {
+			if l.SystemLocaleID == systemID {
+				result[code] = l.ID
+			}
+		}
+	}
+	return result, nil
+}
+
+func knowledgeBaseToClient(plan KnowledgeBase) *client.KnowledgeBase {
+	kb := knowledgeBaseDefaults
+	if !plan.IconSet.IsUnknown() {
+		kb.IconSet = plan.IconSet.ValueString()
+	}
+	if !plan.ColorHighlight.IsUnknown() {
+		kb.ColorHighlight = plan.ColorHighlight.ValueString()
+	}
+	if !plan.ColorHeader.IsUnknown() {
+		kb.ColorHeader = plan.ColorHeader.ValueString()
+	}
+	if !plan.ColorHeaderLink.IsUnknown() {
+		kb.ColorHeaderLink = plan.ColorHeaderLink.ValueString()
+	}
+	if !plan.HomepageLayout.IsUnknown() {
+		kb.HomepageLayout = plan.HomepageLayout.ValueString()
+	}
+	if !plan.CategoryLayout.IsUnknown() {
+		kb.CategoryLayout = plan.CategoryLayout.ValueString()
+	}
+	if !plan.CustomAddress.IsNull() {
+		address := plan.CustomAddress.ValueString()
+		kb.CustomAddress = &address
+	}
+	kb.ShowFeedIcon = plan.ShowFeedIcon.ValueBool()
+	kb.Active = plan.Active.ValueBool()
+	return &kb
+}
+
+// knowledgeBaseLocalesToClient returns the locales to send for plan, existing
+// locales are updated or removed.
+func knowledgeBaseLocalesToClient(plan KnowledgeBase, current []client.KnowledgeBaseLocale, systemLocales map[string]int) ([]client.KnowledgeBaseLocale, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := []client.KnowledgeBaseLocale{}
+	wanted := map[int]bool{}
+	for code := range plan.Locales {
+		systemID, ok := systemLocales[code]
+		if !ok {
+			diags.AddAttributeError(path.Root("locales").AtMapKey(code), "Unknown locale", fmt.Sprintf("Zammad has no locale %q.", code))
+			continue
+		}
+		wanted[systemID] = true
+		l := client.KnowledgeBaseLocale{
+			SystemLocaleID: systemID,
+			Primary:        code == plan.PrimaryLocale.ValueString(),
+		}
+		for _, c := range current {
+			if c.SystemLocaleID == systemID {
+				l.ID = c.ID
+			}
+		}
+		result = append(result, l)
+	}
+	for _, c := range current {
+		if !wanted[c.SystemLocaleID] {
+			result = append(result, client.KnowledgeBaseLocale{ID: c.ID, SystemLocaleID: c.SystemLocaleID, Destroy: true})
+		}
+	}
+	return result, diags
+}
+
+// knowledgeBaseTranslationsToClient returns the translations to send for plan,
+// kb holds the existing locales and translations.
+func knowledgeBaseTranslationsToClient(plan KnowledgeBase, kb *client.KnowledgeBase, systemLocales map[string]int) []client.KnowledgeBaseTranslation {
+	result := []client.KnowledgeBaseTranslation{}
+	for code, locale := range plan.Locales {
+		for _, l := range kb.Locales {
+			if l.SystemLocaleID != systemLocales[code] {
+				continue
+			}
+			t := client.KnowledgeBaseTranslation{
+				KbLocaleID: l.ID,
+				Title:      locale.Title.ValueString(),
+				FooterNote: locale.FooterNote.ValueString(),
+			}
+			for _, existing := range kb.Translations {
+				if existing.KbLocaleID == l.ID {
+					t.ID = existing.ID
+				}
+			}
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func knowledgeBaseFromClient(kb *client.KnowledgeBase, prior KnowledgeBase, systemLocales map[string]int) KnowledgeBase {
+	result := KnowledgeBase{
+		ID:              types.StringValue(strconv.Itoa(kb.ID)),
+		IconSet:         types.StringValue(kb.IconSet),
+		ColorHighlight:  types.StringValue(kb.ColorHighlight),
+		ColorHeader:     types.StringValue(kb.ColorHeader),
+		ColorHeaderLink: types.StringValue(kb.ColorHeaderLink),
+		HomepageLayout:  types.StringValue(kb.HomepageLayout),
+		CategoryLayout:  types.StringValue(kb.CategoryLayout),
+		CustomAddress:   types.StringNull(),
+		ShowFeedIcon:    types.BoolValue(kb.ShowFeedIcon),
+		Active:          types.BoolValue(kb.Active),
+		PrimaryLocale:   types.StringNull(),
+		Locales:         make(map[string]KnowledgeBaseLocale, len(kb.Locales)),
+		CreatedAt:       types.StringValue(kb.CreatedAt),
+		UpdatedAt:       types.StringValue(kb.UpdatedAt),
+	}
+	if kb.CustomAddress != nil && *kb.CustomAddress != "" {
+		result.CustomAddress = types.StringValue(*kb.CustomAddress)
+	}
+	if prior.ShowFeedIcon.IsNull() && !kb.ShowFeedIcon {
+		result.ShowFeedIcon = types.BoolNull()
+	}
+
+	for code, systemID := range systemLocales {
+		for _, l := range kb.Locales {
+			if l.SystemLocaleID != systemID {
+				continue
+			}
+			if l.Primary {
+				result.PrimaryLocale = types.StringValue(code)
+			}
+			locale := KnowledgeBaseLocale{
+				Title:      types.StringValue(""),
+				FooterNote: types.StringNull(),
+			}
+			for _, t := range kb.Translations {
+				if t.KbLocaleID != l.ID {
+					continue
+				}
+				locale.Title = types.StringValue(t.Title)
+				if t.FooterNote != "" || !prior.Locales[code].FooterNote.IsNull() {
+					locale.FooterNote = types.StringValue(t.FooterNote)
+				}
+			}
+			result.Locales[code] = locale
+		}
+	}
+
+	return result
+}
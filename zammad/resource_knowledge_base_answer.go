@@ -0,0 +1,705 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+const (
+	answerStateDraft    = "draft"
+	answerStateInternal = "internal"
+	answerStatePublic   = "public"
+	answerStateArchived = "archived"
+)
+
+func NewZammadKnowledgeBaseAnswer() resource.Resource {
+	return &resourceKnowledgeBaseAnswer{}
+}
+
+type resourceKnowledgeBaseAnswer struct {
+	client *client.Client
+}
+
+// KnowledgeBaseAnswer Resource schema
+func (r resourceKnowledgeBaseAnswer) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"knowledge_base_id": schema.Int64Attribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"category_id": schema.Int64Attribute{
+				Required: true,
+			},
+			"position": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"translations": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Translations of the answer, keyed by locale of the knowledge base, e.g. en-us.",
+				Validators:  []validator.Map{localeKeyValidator},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							Required: true,
+						},
+						"body": schema.StringAttribute{
+							Required:    true,
+							Description: "Body of the answer, in the format given by body_format. Attachments are referenced by their name, e.g. `<img src=\"screenshot.png\">` or `![Screenshot](screenshot.png)`.",
+						},
+					},
+				},
+			},
+			"body_format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Format of the bodies, html (default) or markdown. Markdown is converted to HTML before it is sent to Zammad.",
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{contentFormatHTML, contentFormatMarkdown},
+				}},
+			},
+			"attachments": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Files attached to the answer, keyed by the name they are uploaded and referenced with. Files are only uploaded again when their content changes.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Required:    true,
+							Description: "Path of the local file.",
+						},
+						"sha256": schema.StringAttribute{
+							Computed:    true,
+							Description: "SHA256 hash of the uploaded content.",
+						},
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the attachment in Zammad.",
+						},
+					},
+				},
+			},
+			"publish_state": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Visibility of the answer: draft (default), internal, public or archived.",
+				Validators: []validator.String{stringOneOfValidator{values: []string{
+					answerStateDraft, answerStateInternal, answerStatePublic, answerStateArchived,
+				}}},
+				PlanModifiers: []planmodifier.String{defaultString{value: answerStateDraft}},
+			},
+			"publish_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC 3339 date at which the answer becomes internal or public, immediately if not set.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceKnowledgeBaseAnswer) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_base_answer"
+}
+
+func (r *resourceKnowledgeBaseAnswer) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceKnowledgeBaseAnswer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan KnowledgeBaseAnswer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kbID := int(plan.KnowledgeBaseID.ValueInt64())
+	locales, err := knowledgeBaseLocaleIDs(r.client, kbID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating knowledge base answer",
+			"Could not read locales of knowledge base: "+err.Error(),
+		)
+		return
+	}
+
+	answerreq := &client.KnowledgeBaseAnswer{KnowledgeBaseID: kbID}
+	resp.Diagnostics.Append(knowledgeBaseAnswerToClient(plan, answerreq, locales)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	answer, err := r.client.CreateKnowledgeBaseAnswer(answerreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating knowledge base answer",
+			"Could not create knowledge base answer, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Attachments can only be uploaded to existing answers, the references
+	// to them are rewritten once they have an ID.
+	if len(plan.Attachments) > 0 {
+		plan.Attachments, err = r.uploadAttachments(answer, plan.Attachments)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating knowledge base answer",
+				"Could not upload attachments of knowledge base answer "+strconv.Itoa(answer.ID)+": "+err.Error(),
+			)
+			return
+		}
+		updatedAnswer := &client.KnowledgeBaseAnswer{ID: answer.ID, KnowledgeBaseID: kbID}
+		resp.Diagnostics.Append(knowledgeBaseAnswerToClient(plan, updatedAnswer, locales)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		mergeKnowledgeBaseAnswerTranslations(updatedAnswer, answer)
+		answer, err = r.client.UpdateKnowledgeBaseAnswer(updatedAnswer)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating knowledge base answer",
+				"Could not update knowledge base answer "+strconv.Itoa(updatedAnswer.ID)+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	answer, err = r.publish(answer, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating knowledge base answer",
+			"Could not publish knowledge base answer "+strconv.Itoa(answer.ID)+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, knowledgeBaseAnswerFromClient(answer, plan, locales))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceKnowledgeBaseAnswer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state KnowledgeBaseAnswer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	answerID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	kbID := int(state.KnowledgeBaseID.ValueInt64())
+
+	answer, err := r.client.GetKnowledgeBaseAnswer(kbID, answerID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading knowledge base answer",
+			"Could not read knowledge base answer "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	locales, err := knowledgeBaseLocaleIDs(r.client, kbID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading knowledge base answer",
+			"Could not read locales of knowledge base: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, knowledgeBaseAnswerFromClient(answer, state, locales))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceKnowledgeBaseAnswer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan KnowledgeBaseAnswer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state KnowledgeBaseAnswer
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	answerID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	kbID := int(plan.KnowledgeBaseID.ValueInt64())
+
+	current, err := r.client.GetKnowledgeBaseAnswer(kbID, answerID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base answer",
+			"Could not read knowledge base answer "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	locales, err := knowledgeBaseLocaleIDs(r.client, kbID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base answer",
+			"Could not read locales of knowledge base: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Attachments, err = r.uploadAttachments(current, plan.Attachments)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base answer",
+			"Could not upload attachments of knowledge base answer "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	updatedAnswer := &client.KnowledgeBaseAnswer{ID: answerID, KnowledgeBaseID: kbID}
+	resp.Diagnostics.Append(knowledgeBaseAnswerToClient(plan, updatedAnswer, locales)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	mergeKnowledgeBaseAnswerTranslations(updatedAnswer, current)
+
+	answer, err := r.client.UpdateKnowledgeBaseAnswer(updatedAnswer)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base answer",
+			"Could not update knowledge base answer "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Replaced and removed files are deleted once the bodies no longer
+	// refer to them. Attachments added in Zammad are left alone.
+	uploaded := map[int64]bool{}
+	for _, a := range plan.Attachments {
+		uploaded[a.ID.ValueInt64()] = true
+	}
+	for name, a := range state.Attachments {
+		if a.ID.IsNull() || uploaded[a.ID.ValueInt64()] {
+			continue
+		}
+		if err := r.client.DeleteKnowledgeBaseAnswerAttachment(answer, int(a.ID.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating knowledge base answer",
+				"Could not delete attachment "+name+" of knowledge base answer "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.PublishState.ValueString() != knowledgeBaseAnswerState(answer) || !plan.PublishAt.Equal(state.PublishAt) {
+		answer, err = r.publish(answer, plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating knowledge base answer",
+				"Could not publish knowledge base answer "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, knowledgeBaseAnswerFromClient(answer, plan, locales))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceKnowledgeBaseAnswer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state KnowledgeBaseAnswer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	answerID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteKnowledgeBaseAnswer(&client.KnowledgeBaseAnswer{
+		ID:              answerID,
+		KnowledgeBaseID: int(state.KnowledgeBaseID.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting knowledge base answer",
+			"Could not delete knowledge base answer "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource, the import identifier is <knowledge_base_id>:<id>.
+func (r resourceKnowledgeBaseAnswer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKnowledgeBaseObject(ctx, req, resp)
+}
+
+// ModifyPlan hashes the attached files. Files with the same hash as in the
+// state keep their attachment, the others are uploaded again.
+func (r resourceKnowledgeBaseAnswer) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var attachments types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attachments"), &attachments)...)
+	if resp.Diagnostics.HasError() || attachments.IsNull() || attachments.IsUnknown() {
+		return
+	}
+	planned := map[string]KnowledgeBaseAnswerAttachment{}
+	resp.Diagnostics.Append(attachments.ElementsAs(ctx, &planned, false)...)
+	var prior map[string]KnowledgeBaseAnswerAttachment
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("attachments"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, a := range planned {
+		if a.Path.IsUnknown() {
+			continue
+		}
+		_, sum, err := readAttachment(a.Path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("attachments").AtMapKey(name).AtName("path"),
+				"Error reading attachment",
+				err.Error(),
+			)
+			continue
+		}
+		a.SHA256 = types.StringValue(sum)
+		a.ID = types.Int64Unknown()
+		if p, ok := prior[name]; ok && p.SHA256.ValueString() == sum && !p.ID.IsNull() {
+			a.ID = p.ID
+		}
+		planned[name] = a
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("attachments"), planned)...)
+}
+
+// uploadAttachments uploads the files which have no attachment yet and
+// returns the attachments with their IDs.
+func (r resourceKnowledgeBaseAnswer) uploadAttachments(answer *client.KnowledgeBaseAnswer, attachments map[string]KnowledgeBaseAnswerAttachment) (map[string]KnowledgeBaseAnswerAttachment, error) {
+	if attachments == nil {
+		return nil, nil
+	}
+	existing := map[int]bool{}
+	for _, a := range answer.Attachments {
+		existing[a.ID] = true
+	}
+	names := make([]string, 0, len(attachments))
+	for name := range attachments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make(map[string]KnowledgeBaseAnswerAttachment, len(attachments))
+	for _, name := range names {
+		a := attachments[name]
+		if !a.ID.IsUnknown() && !a.ID.IsNull() && existing[int(a.ID.ValueInt64())] {
+			result[name] = a
+			continue
+		}
+		content, sum, err := readAttachment(a.Path.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		uploaded, err := r.client.AddKnowledgeBaseAnswerAttachment(answer, name, content)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		a.SHA256 = types.StringValue(sum)
+		a.ID = types.Int64Value(int64(uploaded.ID))
+		result[name] = a
+	}
+	return result, nil
+}
+
+// readAttachment returns the content of a file and its SHA256 hash.
+func readAttachment(filename string) ([]byte, string, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(content)
+	return content, hex.EncodeToString(sum[:]), nil
+}
+
+// attachmentURL returns the URL Zammad serves an attachment at.
+func attachmentURL(id int64) string {
+	return "/api/v1/attachments/" + strconv.FormatInt(id, 10)
+}
+
+// rewriteAttachmentReferences replaces the names of attachments in src and
+// href attributes by their URL.
+func rewriteAttachmentReferences(content string, attachments map[string]KnowledgeBaseAnswerAttachment) string {
+	for name, a := range attachments {
+		if a.ID.IsNull() || a.ID.IsUnknown() {
+			continue
+		}
+		re := regexp.MustCompile(`((?:src|href)=")` + regexp.QuoteMeta(name) + `"`)
+		content = re.ReplaceAllString(content, "${1}"+attachmentURL(a.ID.ValueInt64())+`"`)
+	}
+	return content
+}
+
+// restoreAttachmentReferences reverts rewriteAttachmentReferences.
+func restoreAttachmentReferences(content string, attachments map[string]KnowledgeBaseAnswerAttachment) string {
+	for name, a := range attachments {
+		if a.ID.IsNull() || a.ID.IsUnknown() {
+			continue
+		}
+		re := regexp.MustCompile(`((?:src|href)=")` + regexp.QuoteMeta(attachmentURL(a.ID.ValueInt64())) + `"`)
+		content = re.ReplaceAllString(content, "${1}"+strings.ReplaceAll(name, "$", "$$")+`"`)
+	}
+	return content
+}
+
+// publish moves the answer to the publish state of the plan. Zammad only
+// allows to archive answers which are internal or public, and to publish
+// answers which are not archived.
+func (r resourceKnowledgeBaseAnswer) publish(answer *client.KnowledgeBaseAnswer, plan KnowledgeBaseAnswer) (*client.KnowledgeBaseAnswer, error) {
+	var commands []string
+	current := knowledgeBaseAnswerState(answer)
+	switch plan.PublishState.ValueString() {
+	case answerStateDraft:
+		switch current {
+		case answerStateArchived:
+			commands = []string{"unarchive"}
+		case answerStateInternal, answerStatePublic:
+			commands = []string{"archive", "unarchive"}
+		}
+	case answerStateInternal:
+		if current == answerStateArchived {
+			commands = append(commands, "unarchive")
+		}
+		commands = append(commands, "internal")
+	case answerStatePublic:
+		if current == answerStateArchived {
+			commands = append(commands, "unarchive")
+		}
+		commands = append(commands, "publish")
+	case answerStateArchived:
+		if current == answerStateDraft {
+			commands = append(commands, "internal")
+		}
+		if current != answerStateArchived {
+			commands = append(commands, "archive")
+		}
+	}
+	if len(commands) == 0 {
+		return answer, nil
+	}
+
+	for _, command := range commands {
+		date := ""
+		if command == "internal" || command == "publish" {
+			date = plan.PublishAt.ValueString()
+		}
+		if err := r.client.PublishKnowledgeBaseAnswer(answer.KnowledgeBaseID, answer.ID, command, date); err != nil {
+			return answer, err
+		}
+	}
+	return r.client.GetKnowledgeBaseAnswer(answer.KnowledgeBaseID, answer.ID)
+}
+
+// knowledgeBaseAnswerState returns the publish state of an answer. Answers
+// scheduled to become internal or public are reported in that state.
+func knowledgeBaseAnswerState(answer *client.KnowledgeBaseAnswer) string {
+	switch {
+	case answer.ArchivedAt != nil:
+		return answerStateArchived
+	case answer.PublishedAt != nil:
+		return answerStatePublic
+	case answer.InternalAt != nil:
+		return answerStateInternal
+	}
+	return answerStateDraft
+}
+
+func knowledgeBaseAnswerToClient(plan KnowledgeBaseAnswer, answer *client.KnowledgeBaseAnswer, locales map[string]int) diag.Diagnostics {
+	var diags diag.Diagnostics
+	answer.CategoryID = int(plan.CategoryID.ValueInt64())
+	answer.Position = int(plan.Position.ValueInt64())
+	for code, t := range plan.Translations {
+		localeID, ok := locales[code]
+		if !ok {
+			diags.AddAttributeError(path.Root("translations").AtMapKey(code), "Unknown locale", "The knowledge base has no locale "+code+".")
+			continue
+		}
+		body, err := contentToHTML(t.Body.ValueString(), plan.BodyFormat.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("translations").AtMapKey(code).AtName("body"), "Invalid body", err.Error())
+			continue
+		}
+		answer.Translations = append(answer.Translations, client.KnowledgeBaseAnswerTranslation{
+			KbLocaleID: localeID,
+			Title:      t.Title.ValueString(),
+			Content: &client.KnowledgeBaseAnswerTranslationContent{
+				Body: rewriteAttachmentReferences(body, plan.Attachments),
+			},
+		})
+	}
+	return diags
+}
+
+// mergeKnowledgeBaseAnswerTranslations sets the IDs of the existing
+// translations of current in answer, and removes the translations which are
+// no longer wanted.
+func mergeKnowledgeBaseAnswerTranslations(answer, current *client.KnowledgeBaseAnswer) {
+	wanted := map[int]bool{}
+	for i, t := range answer.Translations {
+		wanted[t.KbLocaleID] = true
+		for _, existing := range current.Translations {
+			if existing.KbLocaleID != t.KbLocaleID {
+				continue
+			}
+			answer.Translations[i].ID = existing.ID
+			if existing.Content != nil {
+				answer.Translations[i].Content.ID = existing.Content.ID
+			}
+		}
+	}
+	for _, existing := range current.Translations {
+		if !wanted[existing.KbLocaleID] {
+			answer.Translations = append(answer.Translations, client.KnowledgeBaseAnswerTranslation{
+				ID:         existing.ID,
+				KbLocaleID: existing.KbLocaleID,
+				Title:      existing.Title,
+				Destroy:    true,
+			})
+		}
+	}
+}
+
+func knowledgeBaseAnswerFromClient(answer *client.KnowledgeBaseAnswer, prior KnowledgeBaseAnswer, locales map[string]int) KnowledgeBaseAnswer {
+	result := KnowledgeBaseAnswer{
+		ID:              types.StringValue(strconv.Itoa(answer.ID)),
+		KnowledgeBaseID: types.Int64Value(int64(answer.KnowledgeBaseID)),
+		CategoryID:      types.Int64Value(int64(answer.CategoryID)),
+		Position:        types.Int64Value(int64(answer.Position)),
+		Translations:    make(map[string]KnowledgeBaseAnswerTranslation, len(answer.Translations)),
+		BodyFormat:      prior.BodyFormat,
+		PublishState:    types.StringValue(knowledgeBaseAnswerState(answer)),
+		// Zammad does not tell which date was requested once it passed.
+		PublishAt: prior.PublishAt,
+		CreatedAt: types.StringValue(answer.CreatedAt),
+		UpdatedAt: types.StringValue(answer.UpdatedAt),
+	}
+	if result.PublishAt.IsUnknown() {
+		result.PublishAt = types.StringNull()
+	}
+
+	// Attachments which were deleted in Zammad are uploaded again.
+	if prior.Attachments != nil {
+		existing := map[int64]bool{}
+		for _, a := range answer.Attachments {
+			existing[int64(a.ID)] = true
+		}
+		result.Attachments = make(map[string]KnowledgeBaseAnswerAttachment, len(prior.Attachments))
+		for name, a := range prior.Attachments {
+			if existing[a.ID.ValueInt64()] {
+				result.Attachments[name] = a
+			}
+		}
+	}
+	for _, t := range answer.Translations {
+		code, ok := knowledgeBaseLocaleCode(locales, t.KbLocaleID)
+		if !ok {
+			continue
+		}
+		body := ""
+		if t.Content != nil {
+			body = t.Content.Body
+		}
+		result.Translations[code] = KnowledgeBaseAnswerTranslation{
+			Title: types.StringValue(t.Title),
+			Body: types.StringValue(contentFromHTML(
+				restoreAttachmentReferences(body, result.Attachments),
+				prior.Translations[code].Body.ValueString(),
+				prior.BodyFormat.ValueString(),
+			)),
+		}
+	}
+	return result
+}
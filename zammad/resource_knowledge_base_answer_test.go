@@ -0,0 +1,210 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceKnowledgeBaseAnswer{}
+
+func TestAccBasicKnowledgeBaseAnswerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKnowledgeBaseAnswerResourceConfig("<p>Hello</p>", "draft"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_knowledge_base_answer.test", "translations.en-us.title", "Welcome"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base_answer.test", "translations.en-us.body", "<p>Hello</p>"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base_answer.test", "publish_state", "draft"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_knowledge_base_answer.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccKnowledgeBaseObjectImportID("zammad_knowledge_base_answer.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccKnowledgeBaseAnswerResourceConfig("<p>Hello world</p>", "public"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_knowledge_base_answer.test", "translations.en-us.body", "<p>Hello world</p>"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base_answer.test", "publish_state", "public"),
+				),
+			},
+			{
+				Config: testAccKnowledgeBaseAnswerResourceConfig("<p>Hello world</p>", "archived"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_knowledge_base_answer.test", "publish_state", "archived"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccKnowledgeBaseAnswerAttachments(t *testing.T) {
+	screenshot := filepath.Join(t.TempDir(), "screenshot.png")
+	writeScreenshot := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(screenshot, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeScreenshot("one")()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKnowledgeBaseAnswerAttachmentsConfig(screenshot),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_knowledge_base_answer.test", "translations.en-us.body", "![Screenshot](screenshot.png)"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base_answer.test", "attachments.screenshot.png.sha256", "7692c3ad3540bb803c020b3aee66cd8887123234ea0c6e7143c0add73ff431ed"),
+					resource.TestCheckResourceAttrSet("zammad_knowledge_base_answer.test", "attachments.screenshot.png.id"),
+				),
+			},
+			// Unchanged files are not uploaded again
+			{
+				Config:   testAccKnowledgeBaseAnswerAttachmentsConfig(screenshot),
+				PlanOnly: true,
+			},
+			// Changed files are uploaded again
+			{
+				PreConfig: writeScreenshot("two"),
+				Config:    testAccKnowledgeBaseAnswerAttachmentsConfig(screenshot),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_knowledge_base_answer.test", "attachments.screenshot.png.sha256", "3fc4ccfe745870e2c0d99f71f30ff0656c8dedd41cc1d7d3d376b0dbe685e2f3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAttachmentReferences(t *testing.T) {
+	attachments := map[string]KnowledgeBaseAnswerAttachment{
+		"screenshot.png": {ID: types.Int64Value(42)},
+		"guide.pdf":      {ID: types.Int64Value(43)},
+		"pending.png":    {ID: types.Int64Unknown()},
+	}
+	for _, tc := range []struct {
+		source string
+		html   string
+	}{
+		{`<p><img src="screenshot.png" alt="Screenshot"></p>`, `<p><img src="/api/v1/attachments/42" alt="Screenshot"></p>`},
+		{`<a href="guide.pdf">Guide</a>`, `<a href="/api/v1/attachments/43">Guide</a>`},
+		{`<img src="pending.png">`, `<img src="pending.png">`},
+		{`<p>screenshot.png</p>`, `<p>screenshot.png</p>`},
+	} {
+		got := rewriteAttachmentReferences(tc.source, attachments)
+		if got != tc.html {
+			t.Errorf("rewriteAttachmentReferences(%q) = %q, want %q", tc.source, got, tc.html)
+		}
+		if restored := restoreAttachmentReferences(got, attachments); restored != tc.source {
+			t.Errorf("restoreAttachmentReferences(%q) = %q, want %q", got, restored, tc.source)
+		}
+	}
+}
+
+func testAccKnowledgeBaseAnswerAttachmentsConfig(screenshot string) string {
+	return fmt.Sprintf(`
+resource "zammad_knowledge_base" "test" {
+	primary_locale = "en-us"
+	locales = {
+		"en-us" = {
+			title = "Help"
+		}
+	}
+}
+
+resource "zammad_knowledge_base_category" "test" {
+	knowledge_base_id = zammad_knowledge_base.test.id
+	category_icon     = "f115"
+	translations = {
+		"en-us" = {
+			title = "Getting started"
+		}
+	}
+}
+
+resource "zammad_knowledge_base_answer" "test" {
+	knowledge_base_id = zammad_knowledge_base.test.id
+	category_id       = zammad_knowledge_base_category.test.id
+	publish_state     = "internal"
+	body_format       = "markdown"
+	translations = {
+		"en-us" = {
+			title = "Welcome"
+			body  = "![Screenshot](screenshot.png)"
+		}
+	}
+	attachments = {
+		"screenshot.png" = {
+			path = %q
+		}
+	}
+}
+`, screenshot)
+}
+
+func testAccKnowledgeBaseAnswerResourceConfig(body, state string) string {
+	return fmt.Sprintf(`
+resource "zammad_knowledge_base" "test" {
+	primary_locale = "en-us"
+	locales = {
+		"en-us" = {
+			title = "Help"
+		}
+	}
+}
+
+resource "zammad_knowledge_base_category" "test" {
+	knowledge_base_id = zammad_knowledge_base.test.id
+	category_icon     = "f115"
+	translations = {
+		"en-us" = {
+			title = "Getting started"
+		}
+	}
+}
+
+resource "zammad_knowledge_base_answer" "test" {
+	knowledge_base_id = zammad_knowledge_base.test.id
+	category_id       = zammad_knowledge_base_category.test.id
+	publish_state     = "%s"
+	translations = {
+		"en-us" = {
+			title = "Welcome"
+			body  = "%s"
+		}
+	}
+}
+`, state, body)
+}
@@ -0,0 +1,387 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadKnowledgeBaseCategory() resource.Resource {
+	return &resourceKnowledgeBaseCategory{}
+}
+
+type resourceKnowledgeBaseCategory struct {
+	client *client.Client
+}
+
+// KnowledgeBaseCategory Resource schema
+func (r resourceKnowledgeBaseCategory) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"knowledge_base_id": schema.Int64Attribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"parent_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the parent category, categories without parent are shown on the homepage.",
+			},
+			"category_icon": schema.StringAttribute{
+				Required:    true,
+				Description: "Code of the icon in the icon set of the knowledge base, e.g. f115.",
+			},
+			"position": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"translations": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Translations of the category, keyed by locale of the knowledge base, e.g. en-us.",
+				Validators:  []validator.Map{localeKeyValidator},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceKnowledgeBaseCategory) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_base_category"
+}
+
+func (r *resourceKnowledgeBaseCategory) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceKnowledgeBaseCategory) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan KnowledgeBaseCategory
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kbID := int(plan.KnowledgeBaseID.ValueInt64())
+	locales, err := knowledgeBaseLocaleIDs(r.client, kbID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating knowledge base category",
+			"Could not read locales of knowledge base: "+err.Error(),
+		)
+		return
+	}
+
+	catreq := &client.KnowledgeBaseCategory{
+		KnowledgeBaseID: kbID,
+		ParentID:        int64PointerValue(plan.ParentID),
+		CategoryIcon:    plan.CategoryIcon.ValueString(),
+		Position:        int(plan.Position.ValueInt64()),
+	}
+	for code, t := range plan.Translations {
+		localeID, ok := locales[code]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("translations").AtMapKey(code), "Unknown locale", "The knowledge base has no locale "+code+".")
+			continue
+		}
+		catreq.Translations = append(catreq.Translations, client.KnowledgeBaseCategoryTranslation{
+			KbLocaleID: localeID,
+			Title:      t.Title.ValueString(),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cat, err := r.client.CreateKnowledgeBaseCategory(catreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating knowledge base category",
+			"Could not create knowledge base category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, knowledgeBaseCategoryFromClient(cat, locales))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceKnowledgeBaseCategory) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state KnowledgeBaseCategory
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	kbID := int(state.KnowledgeBaseID.ValueInt64())
+
+	cat, err := r.client.GetKnowledgeBaseCategory(kbID, catID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading knowledge base category",
+			"Could not read knowledge base category "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	locales, err := knowledgeBaseLocaleIDs(r.client, kbID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading knowledge base category",
+			"Could not read locales of knowledge base: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, knowledgeBaseCategoryFromClient(cat, locales))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceKnowledgeBaseCategory) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan KnowledgeBaseCategory
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state KnowledgeBaseCategory
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	kbID := int(plan.KnowledgeBaseID.ValueInt64())
+
+	current, err := r.client.GetKnowledgeBaseCategory(kbID, catID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base category",
+			"Could not read knowledge base category "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	locales, err := knowledgeBaseLocaleIDs(r.client, kbID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base category",
+			"Could not read locales of knowledge base: "+err.Error(),
+		)
+		return
+	}
+
+	updatedCat := &client.KnowledgeBaseCategory{
+		ID:              catID,
+		KnowledgeBaseID: kbID,
+		ParentID:        int64PointerValue(plan.ParentID),
+		CategoryIcon:    plan.CategoryIcon.ValueString(),
+		Position:        int(plan.Position.ValueInt64()),
+	}
+	wanted := map[int]bool{}
+	for code, t := range plan.Translations {
+		localeID, ok := locales[code]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("translations").AtMapKey(code), "Unknown locale", "The knowledge base has no locale "+code+".")
+			continue
+		}
+		wanted[localeID] = true
+		translation := client.KnowledgeBaseCategoryTranslation{
+			KbLocaleID: localeID,
+			Title:      t.Title.ValueString(),
+		}
+		for _, existing := range current.Translations {
+			if existing.KbLocaleID == localeID {
+				translation.ID = existing.ID
+			}
+		}
+		updatedCat.Translations = append(updatedCat.Translations, translation)
+	}
+	for _, existing := range current.Translations {
+		if !wanted[existing.KbLocaleID] {
+			existing.Destroy = true
+			updatedCat.Translations = append(updatedCat.Translations, existing)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cat, err := r.client.UpdateKnowledgeBaseCategory(updatedCat)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating knowledge base category",
+			"Could not update knowledge base category "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, knowledgeBaseCategoryFromClient(cat, locales))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceKnowledgeBaseCategory) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state KnowledgeBaseCategory
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteKnowledgeBaseCategory(&client.KnowledgeBaseCategory{
+		ID:              catID,
+		KnowledgeBaseID: int(state.KnowledgeBaseID.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting knowledge base category",
+			"Could not delete knowledge base category "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource, the import identifier is <knowledge_base_id>:<id>.
+func (r resourceKnowledgeBaseCategory) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKnowledgeBaseObject(ctx, req, resp)
+}
+
+// importKnowledgeBaseObject imports a category or an answer of a knowledge
+// base from <knowledge_base_id>:<id>.
+func importKnowledgeBaseObject(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			"Expected <knowledge_base_id>:<id>, got "+req.ID,
+		)
+		return
+	}
+	kbID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			"Could convert knowledge base id "+parts[0]+": "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("knowledge_base_id"), int64(kbID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// knowledgeBaseLocaleCode returns the locale of the knowledge base locale
+// with the given ID.
+func knowledgeBaseLocaleCode(locales map[string]int, id int) (string, bool) {
+	for code, localeID := range locales {
+		if localeID == id {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+func knowledgeBaseCategoryFromClient(cat *client.KnowledgeBaseCategory, locales map[string]int) KnowledgeBaseCategory {
+	result := KnowledgeBaseCategory{
+		ID:              types.StringValue(strconv.Itoa(cat.ID)),
+		KnowledgeBaseID: types.Int64Value(int64(cat.KnowledgeBaseID)),
+		ParentID:        int64PointerToValue(cat.ParentID),
+		CategoryIcon:    types.StringValue(cat.CategoryIcon),
+		Position:        types.Int64Value(int64(cat.Position)),
+		Translations:    make(map[string]KnowledgeBaseCategoryTranslation, len(cat.Translations)),
+		CreatedAt:       types.StringValue(cat.CreatedAt),
+		UpdatedAt:       types.StringValue(cat.UpdatedAt),
+	}
+	for _, t := range cat.Translations {
+		code, ok := knowledgeBaseLocaleCode(locales, t.KbLocaleID)
+		if !ok {
+			continue
+		}
+		result.Translations[code] = KnowledgeBaseCategoryTranslation{
+			Title: types.StringValue(t.Title),
+		}
+	}
+	return result
+}
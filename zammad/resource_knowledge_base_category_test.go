@@ -0,0 +1,94 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceKnowledgeBaseCategory{}
+
+func TestAccBasicKnowledgeBaseCategoryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKnowledgeBaseCategoryResourceConfig("Getting started"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_knowledge_base_category.test", "category_icon", "f115"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base_category.test", "translations.en-us.title", "Getting started"),
+					resource.TestCheckResourceAttrSet("zammad_knowledge_base_category.test", "position"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_knowledge_base_category.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccKnowledgeBaseObjectImportID("zammad_knowledge_base_category.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccKnowledgeBaseCategoryResourceConfig("First steps"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_knowledge_base_category.test", "translations.en-us.title", "First steps"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccKnowledgeBaseObjectImportID returns the import identifier of a
+// category or an answer.
+func testAccKnowledgeBaseObjectImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+		return rs.Primary.Attributes["knowledge_base_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+func testAccKnowledgeBaseCategoryResourceConfig(title string) string {
+	return fmt.Sprintf(`
+resource "zammad_knowledge_base" "test" {
+	primary_locale = "en-us"
+	locales = {
+		"en-us" = {
+			title = "Help"
+		}
+	}
+}
+
+resource "zammad_knowledge_base_category" "test" {
+	knowledge_base_id = zammad_knowledge_base.test.id
+	category_icon     = "f115"
+	translations = {
+		"en-us" = {
+			title = "%s"
+		}
+	}
+}
+`, title)
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceKnowledgeBase{}
+
+func TestAccBasicKnowledgeBaseResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKnowledgeBaseResourceConfig("Help", "grid"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_knowledge_base.test", "primary_locale", "en-us"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base.test", "locales.en-us.title", "Help"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base.test", "homepage_layout", "grid"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base.test", "iconset", "FontAwesome"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_knowledge_base.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccKnowledgeBaseResourceConfig("Support", "list"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_knowledge_base.test", "locales.en-us.title", "Support"),
+					resource.TestCheckResourceAttr("zammad_knowledge_base.test", "homepage_layout", "list"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccKnowledgeBaseResourceConfig(title, layout string) string {
+	return fmt.Sprintf(`
+resource "zammad_knowledge_base" "test" {
+	homepage_layout = "%s"
+	primary_locale  = "en-us"
+	locales = {
+		"en-us" = {
+			title = "%s"
+		}
+	}
+}
+`, layout, title)
+}
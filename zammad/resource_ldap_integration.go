@@ -0,0 +1,167 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+const ldapIntegrationSetting = "ldap_integration"
+
+func NewZammadLdapIntegration() resource.Resource {
+	return &resourceLdapIntegration{}
+}
+
+type resourceLdapIntegration struct {
+	client *client.Client
+}
+
+// LdapIntegration Resource schema
+func (r resourceLdapIntegration) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable the synchronization of the LDAP sources. Defaults to true, the integration is disabled on destroy.",
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *resourceLdapIntegration) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_integration"
+}
+
+func (r *resourceLdapIntegration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create enables the integration
+func (r resourceLdapIntegration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan LdapIntegration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(plan.Enabled.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceLdapIntegration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	setting, err := r.client.GetSettingByName(ldapIntegrationSetting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading LDAP integration",
+			"Could not read setting "+ldapIntegrationSetting+": "+err.Error(),
+		)
+		return
+	}
+
+	result, diags := ldapIntegrationFromClient(setting)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceLdapIntegration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan LdapIntegration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(plan.Enabled.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete disables the integration
+func (r resourceLdapIntegration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	_, diags := r.apply(false)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Import resource
+func (r resourceLdapIntegration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes the setting and returns the new state.
+func (r resourceLdapIntegration) apply(enabled bool) (LdapIntegration, diag.Diagnostics) {
+	settings, diags := updateSettings(r.client, "LDAP integration", settingValue{ldapIntegrationSetting, enabled})
+	if diags.HasError() {
+		return LdapIntegration{}, diags
+	}
+	return ldapIntegrationFromClient(settings[ldapIntegrationSetting])
+}
+
+func ldapIntegrationFromClient(setting *client.Setting) (LdapIntegration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var enabled bool
+	decodeSetting(setting, &enabled, &diags)
+	return LdapIntegration{
+		ID:      types.StringValue(ldapIntegrationSetting),
+		Enabled: types.BoolValue(enabled),
+	}, diags
+}
@@ -0,0 +1,415 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// ldapUnassignedUsers maps the values of unassigned_users to the values
+// stored by Zammad.
+var ldapUnassignedUsers = map[string]string{
+	"signup_roles": "sigup_roles",
+	"skip_sync":    "skip_sync",
+}
+
+func NewZammadLdapSource() resource.Resource {
+	return &resourceLdapSource{}
+}
+
+type resourceLdapSource struct {
+	client *client.Client
+}
+
+// LdapSource Resource schema
+func (r resourceLdapSource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Host name or IP address of the LDAP server. A port given as part of the host is kept as is, use port to set it separately.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Port of the LDAP server, the default port of the ssl mode if not set.",
+			},
+			"ssl": schema.StringAttribute{
+				Optional:    true,
+				Description: "ssl, starttls or off.",
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{emailSSL, emailStartTLS, emailSSLOff},
+				}},
+			},
+			"ssl_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Verify the certificate of the LDAP server.",
+			},
+			"bind_user": schema.StringAttribute{
+				Optional:    true,
+				Description: "DN of the user to bind with, anonymous bind if not set.",
+			},
+			"bind_pw": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"base_dn": schema.StringAttribute{
+				Required: true,
+			},
+			"user_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "LDAP filter of the users to synchronize, e.g. (objectClass=user).",
+			},
+			"user_uid": schema.StringAttribute{
+				Optional:    true,
+				Description: "LDAP attribute identifying users, e.g. samaccountname.",
+			},
+			"user_attributes": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Zammad user attributes keyed by LDAP attribute, e.g. givenname = firstname.",
+			},
+			"group_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "LDAP filter of the groups, e.g. (objectClass=group).",
+			},
+			"group_role_map": schema.MapAttribute{
+				ElementType: types.SetType{ElemType: types.Int64Type},
+				Optional:    true,
+				Description: "IDs of the roles of the members of LDAP groups, keyed by group DN.",
+			},
+			"unassigned_users": schema.StringAttribute{
+				Optional:    true,
+				Description: "What to do with users which are not in a group of group_role_map: signup_roles assigns the roles of new users, skip_sync does not synchronize them.",
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{"signup_roles", "skip_sync"},
+				}},
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceLdapSource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_source"
+}
+
+func (r *resourceLdapSource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceLdapSource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan LdapSource
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lsreq, diags := ldapSourceToClient(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ls, err := r.client.CreateLdapSource(lsreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ldap_source",
+			"Could not create ldap_source, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, ldapSourceFromClient(ls, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceLdapSource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LdapSource
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lsID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	ls, err := r.client.GetLdapSource(lsID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ldap_source",
+			"Could not read ldap_source "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, ldapSourceFromClient(ls, state))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceLdapSource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan LdapSource
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state LdapSource
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lsID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	updatedLs, diags := ldapSourceToClient(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updatedLs.ID = lsID
+
+	ls, err := r.client.UpdateLdapSource(updatedLs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ldap_source",
+			"Could not update ldap_source "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, ldapSourceFromClient(ls, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceLdapSource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LdapSource
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lsID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteLdapSource(&client.LdapSource{ID: lsID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ldap_source",
+			"Could not delete ldap_source "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceLdapSource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func ldapSourceToClient(ctx context.Context, plan LdapSource) (*client.LdapSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	prefs := client.LdapSourcePreferences{
+		Host:            plan.Host.ValueString(),
+		SSL:             plan.SSL.ValueString(),
+		BindUser:        plan.BindUser.ValueString(),
+		BindPw:          plan.BindPw.ValueString(),
+		BaseDN:          plan.BaseDN.ValueString(),
+		UserFilter:      plan.UserFilter.ValueString(),
+		UserUID:         plan.UserUID.ValueString(),
+		GroupFilter:     plan.GroupFilter.ValueString(),
+		UnassignedUsers: ldapUnassignedUsers[plan.UnassignedUsers.ValueString()],
+	}
+	// Zammad takes the port as part of the host, IPv6 addresses are
+	// bracketed.
+	if !plan.Port.IsNull() {
+		prefs.Host = net.JoinHostPort(prefs.Host, strconv.FormatInt(plan.Port.ValueInt64(), 10))
+	}
+	if !plan.SSLVerify.IsNull() {
+		verify := plan.SSLVerify.ValueBool()
+		prefs.SSLVerify = &verify
+	}
+	if !plan.UserAttributes.IsNull() {
+		diags.Append(plan.UserAttributes.ElementsAs(ctx, &prefs.UserAttributes, false)...)
+	}
+	if !plan.GroupRoleMap.IsNull() {
+		roles := map[string][]int{}
+		diags.Append(plan.GroupRoleMap.ElementsAs(ctx, &roles, false)...)
+		prefs.GroupRoleMap = make(map[string]client.IDs, len(roles))
+		for dn, ids := range roles {
+			prefs.GroupRoleMap[dn] = ids
+		}
+	}
+	return &client.LdapSource{
+		Name:        plan.Name.ValueString(),
+		Preferences: prefs,
+		Active:      plan.Active.ValueBool(),
+	}, diags
+}
+
+func ldapSourceFromClient(ls *client.LdapSource, prior LdapSource) LdapSource {
+	prefs := ls.Preferences
+	result := LdapSource{
+		ID:              types.StringValue(strconv.Itoa(ls.ID)),
+		Name:            types.StringValue(ls.Name),
+		Host:            types.StringValue(prefs.Host),
+		Port:            types.Int64Null(),
+		SSL:             types.StringValue(prefs.SSL),
+		SSLVerify:       types.BoolNull(),
+		BindUser:        types.StringValue(prefs.BindUser),
+		BindPw:          types.StringValue(prefs.BindPw),
+		BaseDN:          types.StringValue(prefs.BaseDN),
+		UserFilter:      types.StringValue(prefs.UserFilter),
+		UserUID:         types.StringValue(prefs.UserUID),
+		UserAttributes:  types.MapNull(types.StringType),
+		GroupFilter:     types.StringValue(prefs.GroupFilter),
+		GroupRoleMap:    types.MapNull(types.SetType{ElemType: types.Int64Type}),
+		UnassignedUsers: types.StringNull(),
+		Active:          types.BoolValue(ls.Active),
+		CreatedAt:       types.StringValue(ls.CreatedAt),
+		UpdatedAt:       types.StringValue(ls.UpdatedAt),
+	}
+	// The port is only split off if it was set separately, or on import.
+	// Hosts with a port in the config and IPv6 addresses without brackets
+	// are kept as they are.
+	if !prior.Port.IsNull() || prior.Host.IsNull() {
+		if host, portValue, err := net.SplitHostPort(prefs.Host); err == nil {
+			if port, err := strconv.ParseInt(portValue, 10, 64); err == nil {
+				result.Host = types.StringValue(host)
+				result.Port = types.Int64Value(port)
+			}
+		}
+	}
+	if prefs.SSLVerify != nil && (!*prefs.SSLVerify || !prior.SSLVerify.IsNull()) {
+		result.SSLVerify = types.BoolValue(*prefs.SSLVerify)
+	}
+	for value, stored := range ldapUnassignedUsers {
+		if stored == prefs.UnassignedUsers {
+			result.UnassignedUsers = types.StringValue(value)
+		}
+	}
+	if len(prefs.UserAttributes) > 0 || !prior.UserAttributes.IsNull() {
+		attrs := make(map[string]attr.Value, len(prefs.UserAttributes))
+		for k, v := range prefs.UserAttributes {
+			attrs[k] = types.StringValue(v)
+		}
+		result.UserAttributes = types.MapValueMust(types.StringType, attrs)
+	}
+	if len(prefs.GroupRoleMap) > 0 || !prior.GroupRoleMap.IsNull() {
+		roles := make(map[string]attr.Value, len(prefs.GroupRoleMap))
+		for dn, ids := range prefs.GroupRoleMap {
+			roles[dn] = int64SetValue(ids)
+		}
+		result.GroupRoleMap = types.MapValueMust(types.SetType{ElemType: types.Int64Type}, roles)
+	}
+
+	// Zammad does not return the password to every client.
+	if !prior.BindPw.IsNull() {
+		result.BindPw = prior.BindPw
+	}
+	if prior.SSL.IsNull() && prefs.SSL == "" {
+		result.SSL = types.StringNull()
+	}
+	if prior.BindUser.IsNull() && prefs.BindUser == "" {
+		result.BindUser = types.StringNull()
+	}
+	if prior.BindPw.IsNull() && prefs.BindPw == "" {
+		result.BindPw = types.StringNull()
+	}
+	if prior.UserFilter.IsNull() && prefs.UserFilter == "" {
+		result.UserFilter = types.StringNull()
+	}
+	if prior.UserUID.IsNull() && prefs.UserUID == "" {
+		result.UserUID = types.StringNull()
+	}
+	if prior.GroupFilter.IsNull() && prefs.GroupFilter == "" {
+		result.GroupFilter = types.StringNull()
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceLdapSource{}
+
+func TestAccBasicLdapSourceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLdapSourceResourceConfig("ldap.example.com", "skip_sync"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_ldap_source.test", "host", "ldap.example.com"),
+					resource.TestCheckResourceAttr("zammad_ldap_source.test", "port", "636"),
+					resource.TestCheckResourceAttr("zammad_ldap_source.test", "ssl", "ssl"),
+					resource.TestCheckResourceAttr("zammad_ldap_source.test", "user_attributes.givenname", "firstname"),
+					resource.TestCheckResourceAttr("zammad_ldap_source.test", "group_role_map.cn=admins,dc=example,dc=com.#", "1"),
+					resource.TestCheckResourceAttr("zammad_ldap_source.test", "unassigned_users", "skip_sync"),
+					resource.TestCheckResourceAttr("zammad_ldap_source.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "zammad_ldap_source.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bind_pw"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccLdapSourceResourceConfig("ad.example.com", "signup_roles"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_ldap_source.test", "host", "ad.example.com"),
+					resource.TestCheckResourceAttr("zammad_ldap_source.test", "unassigned_users", "signup_roles"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestLdapSourceHostPort(t *testing.T) {
+	tests := []struct {
+		host, stored string
+		port         types.Int64
+	}{
+		{"ldap.example.com", "ldap.example.com:636", types.Int64Value(636)},
+		{"2001:db8::1", "[2001:db8::1]:636", types.Int64Value(636)},
+		{"2001:db8::1", "2001:db8::1", types.Int64Null()},
+		{"ldap.example.com:636", "ldap.example.com:636", types.Int64Null()},
+	}
+	for _, tt := range tests {
+		plan := LdapSource{Host: types.StringValue(tt.host), Port: tt.port}
+		ls, diags := ldapSourceToClient(context.Background(), plan)
+		if diags.HasError() {
+			t.Fatalf("ldapSourceToClient(%s): %v", tt.host, diags)
+		}
+		if ls.Preferences.Host != tt.stored {
+			t.Errorf("ldapSourceToClient(%s, %s) host = %s, want %s", tt.host, tt.port, ls.Preferences.Host, tt.stored)
+		}
+		result := ldapSourceFromClient(ls, plan)
+		if !result.Host.Equal(plan.Host) || !result.Port.Equal(plan.Port) {
+			t.Errorf("ldapSourceFromClient(%s) = %s, %s, want %s, %s", tt.stored, result.Host, result.Port, tt.host, tt.port)
+		}
+	}
+
+	// Imported sources have no prior host, the port is split off.
+	result := ldapSourceFromClient(&client.LdapSource{Preferences: client.LdapSourcePreferences{Host: "[2001:db8::1]:389"}}, LdapSource{})
+	if result.Host.ValueString() != "2001:db8::1" || result.Port.ValueInt64() != 389 {
+		t.Errorf("ldapSourceFromClient([2001:db8::1]:389) = %s, %s on import", result.Host, result.Port)
+	}
+}
+
+func testAccLdapSourceResourceConfig(host, unassigned string) string {
+	return fmt.Sprintf(`
+resource "zammad_ldap_source" "test" {
+	name      = "Directory"
+	host      = "%s"
+	port      = 636
+	ssl       = "ssl"
+	bind_user = "cn=zammad,dc=example,dc=com"
+	bind_pw   = "secret"
+	base_dn   = "dc=example,dc=com"
+
+	user_filter = "(objectClass=user)"
+	user_uid    = "samaccountname"
+	user_attributes = {
+		givenname = "firstname"
+		sn        = "lastname"
+		mail      = "email"
+	}
+
+	group_filter = "(objectClass=group)"
+	group_role_map = {
+		"cn=admins,dc=example,dc=com" = [1]
+	}
+	unassigned_users = "%s"
+}
+`, host, unassigned)
+}
@@ -0,0 +1,807 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// treeOptionSeparator separates the levels of a tree_select value.
+const treeOptionSeparator = "::"
+
+func NewZammadObjectManagerAttribute() resource.Resource {
+	return &resourceObjectManagerAttribute{}
+}
+
+type resourceObjectManagerAttribute struct {
+	client *client.Client
+}
+
+// Object Manager Attribute Resource schema
+func (r resourceObjectManagerAttribute) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"object": schema.StringAttribute{
+				Required:      true,
+				Description:   "Object the attribute belongs to: Ticket, User, Organization or Group.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{"Ticket", "User", "Organization", "Group"},
+				}},
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the attribute, used as database column.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{stringMatchValidator{
+					re:      regexp.MustCompile(`^[a-z][a-z0-9_]*$`),
+					message: "lowercase letters, digits and underscores, starting with a letter",
+				}},
+			},
+			"display": schema.StringAttribute{
+				Required:    true,
+				Description: "Label of the attribute.",
+			},
+			"data_type": schema.StringAttribute{
+				Required:      true,
+				Description:   "One of input, textarea, select, multiselect, tree_select, multi_tree_select, integer, boolean, date or datetime. Zammad cannot change the data type of an attribute, changing it replaces the attribute.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{"input", "textarea", "select", "multiselect", "tree_select", "multi_tree_select", "integer", "boolean", "date", "datetime"},
+				}},
+			},
+			"data_option": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Options of the attribute. Which options are required depends on data_type.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Optional:    true,
+						Description: "Input type of input attributes: text, tel, email, url or password.",
+						Validators: []validator.String{stringOneOfValidator{
+							values: []string{"text", "tel", "email", "url", "password"},
+						}},
+					},
+					"maxlength": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Maximal length of input and textarea attributes.",
+					},
+					"rows": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Number of rows of textarea attributes.",
+					},
+					"linktemplate": schema.StringAttribute{
+						Optional:    true,
+						Description: "Link template for input, select and integer attributes.",
+					},
+					"null": schema.BoolAttribute{
+						Optional:      true,
+						Computed:      true,
+						Description:   "Whether the attribute may be empty. Defaults to true.",
+						PlanModifiers: []planmodifier.Bool{&defaultTrue{}},
+					},
+					"default": schema.StringAttribute{
+						Optional:    true,
+						Description: "Default value. Converted to a number for integer attributes and to a boolean for boolean attributes.",
+					},
+					"options": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Map of value to display name for select, multiselect and boolean attributes. Boolean attributes use the keys true and false.",
+					},
+					"tree_options": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Values of tree_select and multi_tree_select attributes. Levels are separated by ::, e.g. Europe::Germany::Berlin.",
+					},
+					"translate": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether the option names are translated.",
+					},
+					"nulloption": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether an empty option is offered by select attributes.",
+					},
+					"min": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Minimal value of integer attributes.",
+					},
+					"max": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Maximal value of integer attributes.",
+					},
+					"diff": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Default offset of date (in days) and datetime (in hours) attributes.",
+					},
+					"future": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether datetime attributes allow dates in the future.",
+					},
+					"past": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether datetime attributes allow dates in the past.",
+					},
+				},
+			},
+			"screens": schema.MapAttribute{
+				ElementType: types.MapType{
+					ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"shown":    types.BoolType,
+							"required": types.BoolType,
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Visibility of the attribute per screen (e.g. create_middle, edit) and permission (e.g. ticket.agent, ticket.customer).",
+			},
+			"position": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"editable": schema.BoolAttribute{
+				Computed:      true,
+				Description:   "Whether the attribute can be changed, false for built-in attributes.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceObjectManagerAttribute) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_manager_attribute"
+}
+
+func (r *resourceObjectManagerAttribute) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig checks that the data options required by the data type are
+// set, Zammad would reject the attribute otherwise.
+func (r resourceObjectManagerAttribute) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var dataType types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("data_type"), &dataType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || dataType.IsNull() || dataType.IsUnknown() {
+		return
+	}
+
+	var required []string
+	switch dataType.ValueString() {
+	case "input":
+		required = []string{"type", "maxlength"}
+	case "textarea":
+		required = []string{"maxlength"}
+	case "select", "multiselect", "boolean":
+		required = []string{"options"}
+	case "tree_select", "multi_tree_select":
+		required = []string{"tree_options"}
+	case "integer":
+		required = []string{"min", "max"}
+	case "date":
+		required = []string{"diff"}
+	case "datetime":
+		required = []string{"diff", "future", "past"}
+	}
+
+	for _, name := range required {
+		var value attr.Value
+		p := path.Root("data_option").AtName(name)
+		diags := req.Config.GetAttribute(ctx, p, &value)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+		if value == nil || value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				p,
+				"Missing data option",
+				fmt.Sprintf("data_option.%s is required for %s attributes.", name, dataType.ValueString()),
+			)
+		}
+	}
+}
+
+// Create a new resource
+func (r resourceObjectManagerAttribute) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ObjectManagerAttribute
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataOption, diags := objectManagerAttributeDataOptionToClient(ctx, plan.DataType.ValueString(), plan.DataOption)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attrreq := &client.ObjectManagerAttribute{
+		Object:     plan.Object.ValueString(),
+		Name:       plan.Name.ValueString(),
+		Display:    plan.Display.ValueString(),
+		DataType:   plan.DataType.ValueString(),
+		DataOption: dataOption,
+		Screens:    objectManagerAttributeScreensToClient(plan.Screens),
+		Position:   int64PointerValue(plan.Position),
+		Active:     plan.Active.ValueBool(),
+	}
+
+	newattr, err := r.client.CreateObjectManagerAttribute(attrreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating object_manager_attribute",
+			"Could not create object_manager_attribute, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.ExecuteObjectManagerAttributeMigrations()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error executing object_manager_attribute migrations",
+			"Could not execute migrations for object_manager_attribute "+strconv.Itoa(newattr.ID)+": "+err.Error(),
+		)
+		return
+	}
+
+	// The data options and screens only become effective with the migration,
+	// the plan is what was sent.
+	result := plan
+	result.ID = types.StringValue(strconv.Itoa(newattr.ID))
+	result.Position = int64PointerToValue(newattr.Position)
+	result.Active = types.BoolValue(newattr.Active)
+	result.Editable = types.BoolValue(newattr.Editable)
+	result.CreatedByID = types.Int64Value(int64(newattr.CreatedByID))
+	result.UpdatedByID = types.Int64Value(int64(newattr.UpdatedByID))
+	result.CreatedAt = types.StringValue(newattr.CreatedAt)
+	result.UpdatedAt = types.StringValue(newattr.UpdatedAt)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceObjectManagerAttribute) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ObjectManagerAttribute
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attrID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	newattr, err := r.client.GetObjectManagerAttribute(attrID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading object_manager_attribute",
+			"Could not read object_manager_attribute "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Zammad returns the object as object_lookup_id, the object is kept from
+	// the state. After an import it is looked up in the list of attributes,
+	// which contains the object names.
+	if newattr.Object == "" && state.Object.ValueString() == "" {
+		attrs, err := r.client.GetObjectManagerAttributes()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading object_manager_attribute",
+				"Could not read object_manager_attributes: "+err.Error(),
+			)
+			return
+		}
+		for _, a := range attrs {
+			if a.ID == attrID {
+				newattr.Object = a.Object
+			}
+		}
+	}
+	if newattr.Object != "" {
+		state.Object = types.StringValue(newattr.Object)
+	}
+	state.Name = types.StringValue(newattr.Name)
+	state.Display = types.StringValue(newattr.Display)
+	state.DataType = types.StringValue(newattr.DataType)
+	state.DataOption, diags = objectManagerAttributeDataOptionFromClient(newattr.DataOption, state.DataOption)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.Screens != nil || len(newattr.Screens) > 0 {
+		state.Screens = objectManagerAttributeScreensFromClient(newattr.Screens)
+	}
+	state.Position = int64PointerToValue(newattr.Position)
+	state.Active = types.BoolValue(newattr.Active)
+	state.Editable = types.BoolValue(newattr.Editable)
+	state.UpdatedAt = types.StringValue(newattr.UpdatedAt)
+	state.UpdatedByID = types.Int64Value(int64(newattr.UpdatedByID))
+	state.CreatedAt = types.StringValue(newattr.CreatedAt)
+	state.CreatedByID = types.Int64Value(int64(newattr.CreatedByID))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceObjectManagerAttribute) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ObjectManagerAttribute
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state ObjectManagerAttribute
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attrID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	dataOption, diags := objectManagerAttributeDataOptionToClient(ctx, plan.DataType.ValueString(), plan.DataOption)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedAttr := &client.ObjectManagerAttribute{
+		ID:         attrID,
+		Object:     plan.Object.ValueString(),
+		Name:       plan.Name.ValueString(),
+		Display:    plan.Display.ValueString(),
+		DataType:   plan.DataType.ValueString(),
+		DataOption: dataOption,
+		Screens:    objectManagerAttributeScreensToClient(plan.Screens),
+		Position:   int64PointerValue(plan.Position),
+		Active:     plan.Active.ValueBool(),
+	}
+
+	newattr, err := r.client.UpdateObjectManagerAttribute(updatedAttr)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating object_manager_attribute",
+			"Could not update object_manager_attribute "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.ExecuteObjectManagerAttributeMigrations()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error executing object_manager_attribute migrations",
+			"Could not execute migrations for object_manager_attribute "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	result := plan
+	result.ID = types.StringValue(strconv.Itoa(newattr.ID))
+	result.Position = int64PointerToValue(newattr.Position)
+	result.Active = types.BoolValue(newattr.Active)
+	result.Editable = types.BoolValue(newattr.Editable)
+	result.CreatedByID = types.Int64Value(int64(newattr.CreatedByID))
+	result.UpdatedByID = types.Int64Value(int64(newattr.UpdatedByID))
+	result.CreatedAt = types.StringValue(newattr.CreatedAt)
+	result.UpdatedAt = types.StringValue(newattr.UpdatedAt)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceObjectManagerAttribute) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ObjectManagerAttribute
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attrID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteObjectManagerAttribute(&client.ObjectManagerAttribute{ID: attrID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting object_manager_attribute",
+			"Could not delete object_manager_attribute "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Zammad only marks the attribute for deletion, the migration drops it.
+	err = r.client.ExecuteObjectManagerAttributeMigrations()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error executing object_manager_attribute migrations",
+			"Could not execute migrations for object_manager_attribute "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceObjectManagerAttribute) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// objectManagerAttributeTreeOption is a node of the options of tree_select
+// attributes.
+type objectManagerAttributeTreeOption struct {
+	Name     string                             `json:"name"`
+	Value    string                             `json:"value"`
+	Children []objectManagerAttributeTreeOption `json:"children,omitempty"`
+}
+
+// treeOptionsFromPaths builds the option tree from the values, missing parent
+// nodes are added.
+func treeOptionsFromPaths(paths []string) []objectManagerAttributeTreeOption {
+	var tree []objectManagerAttributeTreeOption
+	for _, p := range paths {
+		level := &tree
+		parts := strings.Split(p, treeOptionSeparator)
+		for i, name := range parts {
+			value := strings.Join(parts[:i+1], treeOptionSeparator)
+			idx := -1
+			for j := range *level {
+				if (*level)[j].Value == value {
+					idx = j
+					break
+				}
+			}
+			if idx == -1 {
+				*level = append(*level, objectManagerAttributeTreeOption{Name: name, Value: value})
+				idx = len(*level) - 1
+			}
+			level = &(*level)[idx].Children
+		}
+	}
+	return tree
+}
+
+// treeOptionsToPaths returns the values of all the nodes of the tree.
+func treeOptionsToPaths(tree []objectManagerAttributeTreeOption) []string {
+	var paths []string
+	for _, node := range tree {
+		paths = append(paths, node.Value)
+		paths = append(paths, treeOptionsToPaths(node.Children)...)
+	}
+	return paths
+}
+
+func objectManagerAttributeDataOptionToClient(ctx context.Context, dataType string, do *ObjectManagerAttributeDataOption) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := map[string]interface{}{}
+	if do == nil {
+		return result, diags
+	}
+
+	result["null"] = do.Null.IsUnknown() || do.Null.ValueBool()
+	if !do.Type.IsNull() {
+		result["type"] = do.Type.ValueString()
+	}
+	if !do.Maxlength.IsNull() {
+		result["maxlength"] = do.Maxlength.ValueInt64()
+	}
+	if !do.Rows.IsNull() {
+		result["rows"] = do.Rows.ValueInt64()
+	}
+	if !do.Linktemplate.IsNull() {
+		result["linktemplate"] = do.Linktemplate.ValueString()
+	}
+	if !do.Translate.IsNull() {
+		result["translate"] = do.Translate.ValueBool()
+	}
+	if !do.Nulloption.IsNull() {
+		result["nulloption"] = do.Nulloption.ValueBool()
+	}
+	if !do.Min.IsNull() {
+		result["min"] = do.Min.ValueInt64()
+	}
+	if !do.Max.IsNull() {
+		result["max"] = do.Max.ValueInt64()
+	}
+	if !do.Diff.IsNull() {
+		result["diff"] = do.Diff.ValueInt64()
+	}
+	if !do.Future.IsNull() {
+		result["future"] = do.Future.ValueBool()
+	}
+	if !do.Past.IsNull() {
+		result["past"] = do.Past.ValueBool()
+	}
+	if !do.Options.IsNull() {
+		options := map[string]string{}
+		diags.Append(do.Options.ElementsAs(ctx, &options, false)...)
+		result["options"] = options
+	}
+	if !do.TreeOptions.IsNull() {
+		var paths []string
+		diags.Append(do.TreeOptions.ElementsAs(ctx, &paths, false)...)
+		result["options"] = treeOptionsFromPaths(paths)
+	}
+
+	// Zammad requires the default to be present for attributes with options.
+	switch dataType {
+	case "select", "multiselect", "tree_select", "multi_tree_select", "boolean":
+		result["default"] = nil
+	}
+	if !do.Default.IsNull() {
+		value := do.Default.ValueString()
+		switch dataType {
+		case "integer":
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				diags.AddAttributeError(path.Root("data_option").AtName("default"), "Invalid default", "The default of integer attributes must be a number: "+err.Error())
+			}
+			result["default"] = i
+		case "boolean":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				diags.AddAttributeError(path.Root("data_option").AtName("default"), "Invalid default", "The default of boolean attributes must be true or false: "+err.Error())
+			}
+			result["default"] = b
+		default:
+			result["default"] = value
+		}
+	}
+
+	return result, diags
+}
+
+// objectManagerAttributeDataOptionFromClient converts the data options
+// returned by Zammad. Only the options set in prior are reported, Zammad adds
+// defaults for the others. Without prior, e.g. on import, all known options
+// are reported.
+func objectManagerAttributeDataOptionFromClient(dataOption map[string]interface{}, prior *ObjectManagerAttributeDataOption) (*ObjectManagerAttributeDataOption, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	all := prior == nil
+	if all {
+		prior = &ObjectManagerAttributeDataOption{}
+	}
+	result := &ObjectManagerAttributeDataOption{
+		Type:         types.StringNull(),
+		Maxlength:    types.Int64Null(),
+		Rows:         types.Int64Null(),
+		Linktemplate: types.StringNull(),
+		Null:         types.BoolValue(true),
+		Default:      types.StringNull(),
+		Options:      types.MapNull(types.StringType),
+		TreeOptions:  types.ListNull(types.StringType),
+		Translate:    types.BoolNull(),
+		Nulloption:   types.BoolNull(),
+		Min:          types.Int64Null(),
+		Max:          types.Int64Null(),
+		Diff:         types.Int64Null(),
+		Future:       types.BoolNull(),
+		Past:         types.BoolNull(),
+	}
+
+	str := func(key string, prior types.String, target *types.String) {
+		if v, ok := dataOption[key]; ok && v != nil && (all || !prior.IsNull()) {
+			*target = types.StringValue(jsonScalarString(v))
+		}
+	}
+	integer := func(key string, prior types.Int64, target *types.Int64) {
+		if v, ok := dataOption[key].(float64); ok && (all || !prior.IsNull()) {
+			*target = types.Int64Value(int64(v))
+		}
+	}
+	boolean := func(key string, prior types.Bool, target *types.Bool) {
+		if v, ok := dataOption[key].(bool); ok && (all || !prior.IsNull()) {
+			*target = types.BoolValue(v)
+		}
+	}
+
+	str("type", prior.Type, &result.Type)
+	integer("maxlength", prior.Maxlength, &result.Maxlength)
+	integer("rows", prior.Rows, &result.Rows)
+	str("linktemplate", prior.Linktemplate, &result.Linktemplate)
+	if v, ok := dataOption["null"].(bool); ok {
+		result.Null = types.BoolValue(v)
+	}
+	str("default", prior.Default, &result.Default)
+	boolean("translate", prior.Translate, &result.Translate)
+	boolean("nulloption", prior.Nulloption, &result.Nulloption)
+	integer("min", prior.Min, &result.Min)
+	integer("max", prior.Max, &result.Max)
+	integer("diff", prior.Diff, &result.Diff)
+	boolean("future", prior.Future, &result.Future)
+	boolean("past", prior.Past, &result.Past)
+
+	switch options := dataOption["options"].(type) {
+	case map[string]interface{}:
+		if all || !prior.Options.IsNull() {
+			elems := make(map[string]attr.Value, len(options))
+			for k, v := range options {
+				elems[k] = types.StringValue(jsonScalarString(v))
+			}
+			result.Options = types.MapValueMust(types.StringType, elems)
+		}
+	case []interface{}:
+		if all || !prior.TreeOptions.IsNull() {
+			tree, err := treeOptionsFromJSON(options)
+			if err != nil {
+				diags.AddError("Error reading tree options", "Could not read the options of the attribute: "+err.Error())
+				return result, diags
+			}
+			var priorPaths []string
+			diags.Append(prior.TreeOptions.ElementsAs(context.Background(), &priorPaths, true)...)
+			paths := priorPaths
+			// Keep the values from the configuration as long as they describe
+			// the same tree, parent nodes do not need to be listed.
+			if !reflect.DeepEqual(treeOptionsFromPaths(priorPaths), tree) {
+				paths = treeOptionsToPaths(tree)
+			}
+			elems := make([]attr.Value, len(paths))
+			for i := range paths {
+				elems[i] = types.StringValue(paths[i])
+			}
+			result.TreeOptions = types.ListValueMust(types.StringType, elems)
+		}
+	}
+
+	return result, diags
+}
+
+func treeOptionsFromJSON(options []interface{}) ([]objectManagerAttributeTreeOption, error) {
+	var tree []objectManagerAttributeTreeOption
+	for _, o := range options {
+		node, ok := o.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected option %v", o)
+		}
+		option := objectManagerAttributeTreeOption{
+			Name:  jsonScalarString(node["name"]),
+			Value: jsonScalarString(node["value"]),
+		}
+		if children, ok := node["children"].([]interface{}); ok {
+			var err error
+			option.Children, err = treeOptionsFromJSON(children)
+			if err != nil {
+				return nil, err
+			}
+		}
+		tree = append(tree, option)
+	}
+	return tree, nil
+}
+
+// jsonScalarString formats a decoded JSON scalar as string.
+func jsonScalarString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func objectManagerAttributeScreensToClient(screens map[string]map[string]ObjectManagerAttributeScreen) map[string]map[string]client.ObjectManagerAttributeScreen {
+	result := make(map[string]map[string]client.ObjectManagerAttributeScreen, len(screens))
+	for screen, permissions := range screens {
+		result[screen] = make(map[string]client.ObjectManagerAttributeScreen, len(permissions))
+		for permission, s := range permissions {
+			result[screen][permission] = client.ObjectManagerAttributeScreen{
+				Shown:    s.Shown.ValueBool(),
+				Required: s.Required.ValueBool(),
+			}
+		}
+	}
+	return result
+}
+
+func objectManagerAttributeScreensFromClient(screens map[string]map[string]client.ObjectManagerAttributeScreen) map[string]map[string]ObjectManagerAttributeScreen {
+	result := make(map[string]map[string]ObjectManagerAttributeScreen, len(screens))
+	for screen, permissions := range screens {
+		result[screen] = make(map[string]ObjectManagerAttributeScreen, len(permissions))
+		for permission, s := range permissions {
+			result[screen][permission] = ObjectManagerAttributeScreen{
+				Shown:    types.BoolValue(s.Shown),
+				Required: types.BoolValue(s.Required),
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var (
+	_ tfresource.ResourceWithSchema         = &resourceObjectManagerAttribute{}
+	_ tfresource.ResourceWithValidateConfig = &resourceObjectManagerAttribute{}
+)
+
+func TestAccSelectObjectManagerAttributeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSelectObjectManagerAttributeResourceConfig("Region", "emea"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "object", "Ticket"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "name", "tf_region"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "display", "Region"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "data_option.options.%", "2"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "data_option.default", "emea"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "data_option.null", "true"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "screens.edit.ticket.agent.shown", "true"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "editable", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_object_manager_attribute.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSelectObjectManagerAttributeResourceConfig("Sales Region", "amer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "display", "Sales Region"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "data_option.default", "amer"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTreeSelectObjectManagerAttributeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTreeSelectObjectManagerAttributeResourceConfig(`"Hardware::Laptop", "Hardware::Printer", "Software"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "data_type", "tree_select"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "data_option.tree_options.#", "3"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTreeSelectObjectManagerAttributeResourceConfig(`"Hardware::Laptop", "Software::Office"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "data_option.tree_options.#", "2"),
+					resource.TestCheckResourceAttr("zammad_object_manager_attribute.test", "data_option.tree_options.1", "Software::Office"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInvalidObjectManagerAttributeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "zammad_object_manager_attribute" "test" {
+	object = "Organization"
+	name = "tf_contract_size"
+	display = "Contract size"
+	data_type = "integer"
+	data_option = {
+		min = 0
+	}
+}
+`,
+				ExpectError: regexp.MustCompile(`data_option.max is required for integer attributes`),
+			},
+		},
+	})
+}
+
+func TestTreeOptions(t *testing.T) {
+	paths := []string{"Hardware::Laptop", "Hardware::Printer", "Software"}
+	tree := treeOptionsFromPaths(paths)
+	want := []objectManagerAttributeTreeOption{
+		{Name: "Hardware", Value: "Hardware", Children: []objectManagerAttributeTreeOption{
+			{Name: "Laptop", Value: "Hardware::Laptop"},
+			{Name: "Printer", Value: "Hardware::Printer"},
+		}},
+		{Name: "Software", Value: "Software"},
+	}
+	if !reflect.DeepEqual(tree, want) {
+		t.Fatalf("treeOptionsFromPaths(%v) = %v, want %v", paths, tree, want)
+	}
+
+	all := treeOptionsToPaths(tree)
+	wantAll := []string{"Hardware", "Hardware::Laptop", "Hardware::Printer", "Software"}
+	if !reflect.DeepEqual(all, wantAll) {
+		t.Fatalf("treeOptionsToPaths() = %v, want %v", all, wantAll)
+	}
+	if !reflect.DeepEqual(treeOptionsFromPaths(all), tree) {
+		t.Fatalf("treeOptionsFromPaths(%v) does not return the original tree", all)
+	}
+}
+
+func testAccSelectObjectManagerAttributeResourceConfig(display, def string) string {
+	return fmt.Sprintf(`
+resource "zammad_object_manager_attribute" "test" {
+	object = "Ticket"
+	name = "tf_region"
+	display = "%s"
+	data_type = "select"
+	data_option = {
+		default = "%s"
+		options = {
+			emea = "EMEA"
+			amer = "Americas"
+		}
+	}
+	screens = {
+		create_middle = {
+			"ticket.agent" = { shown = true, required = false }
+		}
+		edit = {
+			"ticket.agent" = { shown = true, required = false }
+		}
+	}
+	position = 1550
+}
+`, display, def)
+}
+
+func testAccTreeSelectObjectManagerAttributeResourceConfig(options string) string {
+	return fmt.Sprintf(`
+resource "zammad_object_manager_attribute" "test" {
+	object = "Ticket"
+	name = "tf_product"
+	display = "Product"
+	data_type = "tree_select"
+	data_option = {
+		tree_options = [%s]
+	}
+	position = 1560
+}
+`, options)
+}
@@ -71,6 +71,11 @@ func (r resourceOrganization) Schema(_ context.Context, req resource.SchemaReque
 			"note": schema.StringAttribute{
 				Optional: true,
 			},
+			"custom_attributes": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Values of custom object manager attributes, by attribute name. Only the attributes listed here are managed.",
+			},
 			"created_by_id": schema.Int64Attribute{
 				Computed:      true,
 				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
@@ -125,6 +130,7 @@ func (r resourceOrganization) Create(ctx context.Context, req resource.CreateReq
 		Domain:           plan.Domain.ValueString(),
 		DomainAssignment: plan.DomainAssignment.ValueBool(),
 		Shared:           plan.Shared.ValueBool(),
+		Extra:            customAttributesToClient(ctx, plan.CustomAttributes, types.MapNull(types.StringType)),
 	}
 
 	org, err := r.client.CreateOrganization(orgreq)
@@ -154,6 +160,7 @@ func (r resourceOrganization) Create(ctx context.Context, req resource.CreateReq
 		CreatedAt:        types.StringValue(org.CreatedAt),
 		UpdatedAt:        types.StringValue(org.UpdatedAt),
 		MemberIDs:        types.ListValueMust(types.Int64Type, members),
+		CustomAttributes: plan.CustomAttributes,
 	}
 	if plan.Note.IsNull() && org.Note == "" {
 		result.Note = types.StringNull()
@@ -203,6 +210,7 @@ func (r resourceOrganization) Read(ctx context.Context, req resource.ReadRequest
 	state.UpdatedByID = types.Int64Value(int64(neworg.UpdatedByID))
 	state.CreatedAt = types.StringValue(neworg.CreatedAt)
 	state.CreatedByID = types.Int64Value(int64(neworg.CreatedByID))
+	state.CustomAttributes = customAttributesFromClient(neworg.Extra, state.CustomAttributes)
 	if state.Note.IsNull() && neworg.Note == "" {
 		state.Note = types.StringNull()
 	} else {
@@ -252,6 +260,7 @@ func (r resourceOrganization) Update(ctx context.Context, req resource.UpdateReq
 		Active:           plan.Active.ValueBool(),
 		Shared:           plan.Shared.ValueBool(),
 		MemberIDs:        members,
+		Extra:            customAttributesToClient(ctx, plan.CustomAttributes, state.CustomAttributes),
 	}
 
 	org, err := r.client.UpdateOrganization(updatedOrg)
@@ -280,6 +289,7 @@ func (r resourceOrganization) Update(ctx context.Context, req resource.UpdateReq
 		CreatedAt:        types.StringValue(org.CreatedAt),
 		UpdatedAt:        types.StringValue(org.UpdatedAt),
 		MemberIDs:        types.ListValueMust(types.Int64Type, tfmembers),
+		CustomAttributes: plan.CustomAttributes,
 	}
 	if plan.Note.IsNull() && org.Note == "" {
 		result.Note = types.StringNull()
@@ -325,3 +335,37 @@ func (r resourceOrganization) ImportState(ctx context.Context, req resource.Impo
 	// Save the import identifier in the id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// customAttributesToClient returns the custom attribute values to send to
+// Zammad. Attributes which are no longer part of the plan are cleared.
+func customAttributesToClient(ctx context.Context, plan, state types.Map) map[string]interface{} {
+	result := map[string]interface{}{}
+	previous := map[string]string{}
+	state.ElementsAs(ctx, &previous, true)
+	for k := range previous {
+		result[k] = nil
+	}
+	values := map[string]string{}
+	plan.ElementsAs(ctx, &values, true)
+	for k, v := range values {
+		result[k] = v
+	}
+	return result
+}
+
+// customAttributesFromClient returns the values of the custom attributes of
+// prior, other custom attributes are ignored.
+func customAttributesFromClient(extra map[string]interface{}, prior types.Map) types.Map {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior
+	}
+	values := map[string]attr.Value{}
+	for k := range prior.Elements() {
+		v, ok := extra[k]
+		if !ok {
+			continue
+		}
+		values[k] = types.StringValue(jsonScalarString(v))
+	}
+	return types.MapValueMust(types.StringType, values)
+}
@@ -107,6 +107,30 @@ func TestAccAdvancedOrganizationResource(t *testing.T) {
 	})
 }
 
+func TestAccCustomAttributesOrganizationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomAttributesOrganizationResourceConfig("gold"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_organization.test", "custom_attributes.%", "1"),
+					resource.TestCheckResourceAttr("zammad_organization.test", "custom_attributes.tf_customer_tier", "gold"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomAttributesOrganizationResourceConfig("silver"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_organization.test", "custom_attributes.tf_customer_tier", "silver"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccOrganizationResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "zammad_organization" "test" {
@@ -127,3 +151,26 @@ resource "zammad_organization" "test" {
 }
 `, name, active, note, domain, domainAssignment, shared)
 }
+
+func testAccCustomAttributesOrganizationResourceConfig(tier string) string {
+	return fmt.Sprintf(`
+resource "zammad_object_manager_attribute" "tier" {
+	object = "Organization"
+	name = "tf_customer_tier"
+	display = "Customer tier"
+	data_type = "input"
+	data_option = {
+		type = "text"
+		maxlength = 20
+	}
+	position = 1550
+}
+
+resource "zammad_organization" "test" {
+	name = "custom"
+	custom_attributes = {
+		(zammad_object_manager_attribute.tier.name) = "%s"
+	}
+}
+`, tier)
+}
@@ -0,0 +1,287 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadPGPKey() resource.Resource {
+	return &resourcePGPKey{}
+}
+
+type resourcePGPKey struct {
+	client *client.Client
+}
+
+// PGPKey Resource schema
+func (r resourcePGPKey) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "PGP key used by the PGP integration to sign and encrypt emails.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"key": schema.StringAttribute{
+				Required:      true,
+				Sensitive:     true,
+				Description:   "ASCII armored public or private key. Zammad does not return it, after an import it is taken from the configuration.",
+				PlanModifiers: []planmodifier.String{requiresReplaceUnlessImported()},
+				Validators:    []validator.String{pgpArmorValidator{}},
+			},
+			"passphrase": schema.StringAttribute{
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Passphrase of the private key.",
+				PlanModifiers: []planmodifier.String{requiresReplaceUnlessImported()},
+			},
+			"domain_alias": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Domain the key is also used for, e.g. to sign emails of all addresses of a domain.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Computed:      true,
+				Description:   "User ID of the key.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"email_addresses": schema.ListAttribute{
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Email addresses of the user IDs of the key.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Expiry of the key, null if it does not expire.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"secret": schema.BoolAttribute{
+				Computed:      true,
+				Description:   "Whether the key contains a private key.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *resourcePGPKey) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pgp_key"
+}
+
+func (r *resourcePGPKey) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourcePGPKey) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan PGPKey
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newKey := &client.PGPKey{
+		Key:         plan.Key.ValueString(),
+		Passphrase:  plan.Passphrase.ValueString(),
+		DomainAlias: plan.DomainAlias.ValueString(),
+	}
+
+	key, err := r.client.CreatePGPKey(newKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating PGP key",
+			"Could not create PGP key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := pgpKeyFromClient(key, &plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourcePGPKey) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PGPKey
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	key, err := r.keyByID(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading PGP key",
+			"Could not read PGP key "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if key == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := pgpKeyFromClient(key, &state)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only adopts the key and passphrase from the configuration after an
+// import, all other changes require replacement
+func (r resourcePGPKey) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PGPKey
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	key, err := r.client.GetPGPKey(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating PGP key",
+			"Could not read PGP key "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	result := pgpKeyFromClient(key, &plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourcePGPKey) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PGPKey
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeletePGPKey(&client.PGPKey{ID: id})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting PGP key",
+			"Could not delete PGP key "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourcePGPKey) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// keyByID returns the key with the given ID, or nil if it does not exist.
+func (r resourcePGPKey) keyByID(id int) (*client.PGPKey, error) {
+	keys, err := r.client.GetPGPKeys()
+	if err != nil {
+		return nil, err
+	}
+	for i := range keys {
+		if keys[i].ID == id {
+			return &keys[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func pgpKeyFromClient(key *client.PGPKey, prior *PGPKey) *PGPKey {
+	result := &PGPKey{
+		ID:             types.StringValue(strconv.Itoa(key.ID)),
+		Key:            prior.Key,
+		Passphrase:     prior.Passphrase,
+		DomainAlias:    types.StringValue(key.DomainAlias),
+		Fingerprint:    types.StringValue(key.Fingerprint),
+		Name:           types.StringValue(key.Name),
+		EmailAddresses: stringListValue(key.EmailAddresses),
+		ExpiresAt:      types.StringNull(),
+		Secret:         types.BoolValue(key.Secret),
+	}
+	if prior.DomainAlias.IsNull() && key.DomainAlias == "" {
+		result.DomainAlias = types.StringNull()
+	}
+	if key.ExpiresAt != nil {
+		result.ExpiresAt = types.StringValue(*key.ExpiresAt)
+	}
+	return result
+}
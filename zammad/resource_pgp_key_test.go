@@ -0,0 +1,85 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+var _ tfresource.ResourceWithSchema = &resourcePGPKey{}
+
+func TestAccBasicPGPKeyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPGPKeyResourceConfig(testAccPGPPublicKey(t, "pgp@example.com")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_pgp_key.test", "email_addresses.#", "1"),
+					resource.TestCheckResourceAttr("zammad_pgp_key.test", "email_addresses.0", "pgp@example.com"),
+					resource.TestCheckResourceAttr("zammad_pgp_key.test", "secret", "false"),
+					resource.TestCheckResourceAttrSet("zammad_pgp_key.test", "fingerprint"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "zammad_pgp_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "passphrase"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPGPKeyResourceConfig(key string) string {
+	return fmt.Sprintf(`
+resource "zammad_pgp_key" "test" {
+	key = <<EOT
+%s
+EOT
+}
+`, key)
+}
+
+// testAccPGPPublicKey returns a new ASCII armored public key for email.
+func testAccPGPPublicKey(t *testing.T, email string) string {
+	entity, err := openpgp.NewEntity("Terraform Test", "", email, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
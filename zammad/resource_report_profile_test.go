@@ -0,0 +1,122 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceReportProfile{}
+
+func TestAccBasicReportProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccReportProfileResourceConfig("one", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "name", "one"),
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "condition.ticket.group_id.operator", "is"),
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "condition.ticket.group_id.value.0", "1"),
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_report_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccReportProfileResourceConfig("two", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "name", "two"),
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "condition.ticket.group_id.value.0", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRelativeReportProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRelativeReportProfileResourceConfig(`value = ["30"]`),
+				ExpectError: regexp.MustCompile("Missing range"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccRelativeReportProfileResourceConfig(`value = ["30"]
+			range = "day"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "condition.ticket.created_at.operator", "within last (relative)"),
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "condition.ticket.created_at.value.0", "30"),
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "condition.ticket.created_at.range", "day"),
+					resource.TestCheckResourceAttr("zammad_report_profile.test", "condition.ticket.title.value.0", "outage"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_report_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRelativeReportProfileResourceConfig(createdAt string) string {
+	return fmt.Sprintf(`
+resource "zammad_report_profile" "test" {
+	name = "last month outages"
+	condition = {
+		"ticket.created_at" = {
+			operator = "within last (relative)"
+			%s
+		}
+		"ticket.title" = {
+			operator = "contains"
+			value    = ["outage"]
+		}
+	}
+}
+`, createdAt)
+}
+
+func testAccReportProfileResourceConfig(name, groupID string) string {
+	return fmt.Sprintf(`
+resource "zammad_report_profile" "test" {
+	name = "%s"
+	condition = {
+		"ticket.group_id" = {
+			operator = "is"
+			value    = ["%s"]
+		}
+	}
+}
+`, name, groupID)
+}
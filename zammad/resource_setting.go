@@ -0,0 +1,242 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadSetting() resource.Resource {
+	return &resourceSetting{}
+}
+
+type resourceSetting struct {
+	client *client.Client
+}
+
+// Setting Resource schema
+func (r resourceSetting) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the setting, e.g. product_name. Settings are imported by name.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"value_json": schema.StringAttribute{
+				Required:    true,
+				Description: "Value of the setting as JSON, e.g. jsonencode(\"Helpdesk\"). Zammad returning the same document with different formatting or key order is not reported as a change.",
+				Validators:  []validator.String{jsonValidator{}},
+			},
+			"previous_value_json": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Value of the setting before it was managed by Terraform. Settings can not be deleted, this value is restored on destroy.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"title": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceSetting) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setting"
+}
+
+func (r *resourceSetting) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create takes over an existing setting
+func (r resourceSetting) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan Setting
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetSettingByName(plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading setting",
+			"Could not read setting "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	setting, err := r.client.UpdateSetting(current.ID, json.RawMessage(plan.ValueJSON.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating setting",
+			"Could not update setting "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.PreviousValueJSON = types.StringValue(string(current.StateCurrent.Value))
+	diags = resp.State.Set(ctx, settingFromClient(setting, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceSetting) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Setting
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := r.client.GetSettingByName(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading setting",
+			"Could not read setting "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// On import the current value is the one to restore.
+	if state.PreviousValueJSON.IsNull() {
+		state.PreviousValueJSON = types.StringValue(string(setting.StateCurrent.Value))
+	}
+	diags = resp.State.Set(ctx, settingFromClient(setting, state))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceSetting) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Setting
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state Setting
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	setting, err := r.client.UpdateSetting(settingID, json.RawMessage(plan.ValueJSON.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating setting",
+			"Could not update setting "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.PreviousValueJSON = state.PreviousValueJSON
+	diags = resp.State.Set(ctx, settingFromClient(setting, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete restores the previous value of the setting
+func (r resourceSetting) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Setting
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateSetting(settingID, json.RawMessage(state.PreviousValueJSON.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring setting",
+			"Could not restore setting "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceSetting) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Settings are imported by name
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// settingFromClient converts a setting returned by Zammad. The value of prior
+// is kept if it is the same JSON document.
+func settingFromClient(setting *client.Setting, prior Setting) Setting {
+	result := Setting{
+		ID:                types.StringValue(strconv.Itoa(setting.ID)),
+		Name:              types.StringValue(setting.Name),
+		ValueJSON:         types.StringValue(string(setting.StateCurrent.Value)),
+		PreviousValueJSON: prior.PreviousValueJSON,
+		Title:             types.StringValue(setting.Title),
+		UpdatedAt:         types.StringValue(setting.UpdatedAt),
+	}
+	if jsonEqual(prior.ValueJSON.ValueString(), string(setting.StateCurrent.Value)) {
+		result.ValueJSON = prior.ValueJSON
+	}
+	return result
+}
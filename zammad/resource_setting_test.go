@@ -0,0 +1,68 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceSetting{}
+
+func TestAccBasicSettingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSettingResourceConfig(`jsonencode("Helpdesk")`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_setting.test", "name", "product_name"),
+					resource.TestCheckResourceAttr("zammad_setting.test", "value_json", `"Helpdesk"`),
+					resource.TestCheckResourceAttrSet("zammad_setting.test", "previous_value_json"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "zammad_setting.test",
+				ImportState:             true,
+				ImportStateId:           "product_name",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"previous_value_json"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSettingResourceConfig(`jsonencode("Support")`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_setting.test", "value_json", `"Support"`),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSettingResourceConfig(value string) string {
+	return fmt.Sprintf(`
+resource "zammad_setting" "test" {
+	name       = "product_name"
+	value_json = %s
+}
+`, value)
+}
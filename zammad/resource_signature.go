@@ -0,0 +1,288 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadSignature() resource.Resource {
+	return &resourceSignature{}
+}
+
+type resourceSignature struct {
+	client *client.Client
+}
+
+// Signature Resource schema
+func (r resourceSignature) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"body": schema.StringAttribute{
+				Required:    true,
+				Description: "Body of the signature. Placeholders such as #{user.firstname} or #{config.fqdn} are substituted by Zammad.",
+				Validators: []validator.String{placeholderValidator{
+					objects: []string{"user", "ticket", "config", "organization"},
+				}},
+			},
+			"note": schema.StringAttribute{
+				Optional: true,
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceSignature) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_signature"
+}
+
+func (r *resourceSignature) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceSignature) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan Signature
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sigreq := &client.Signature{
+		Name:   plan.Name.ValueString(),
+		Body:   plan.Body.ValueString(),
+		Note:   plan.Note.ValueString(),
+		Active: plan.Active.ValueBool(),
+	}
+
+	sig, err := r.client.CreateSignature(sigreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating signature",
+			"Could not create signature, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := Signature{
+		ID:          types.StringValue(strconv.Itoa(sig.ID)),
+		Name:        types.StringValue(sig.Name),
+		Body:        types.StringValue(sig.Body),
+		Note:        types.StringValue(sig.Note),
+		Active:      types.BoolValue(sig.Active),
+		CreatedByID: types.Int64Value(int64(sig.CreatedByID)),
+		UpdatedByID: types.Int64Value(int64(sig.UpdatedByID)),
+		CreatedAt:   types.StringValue(sig.CreatedAt),
+		UpdatedAt:   types.StringValue(sig.UpdatedAt),
+	}
+	if plan.Note.IsNull() && sig.Note == "" {
+		result.Note = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceSignature) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Signature
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sigID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	newsig, err := r.client.GetSignature(sigID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading signature",
+			"Could not read signature "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(newsig.Name)
+	state.Body = types.StringValue(newsig.Body)
+	state.Active = types.BoolValue(newsig.Active)
+	state.UpdatedAt = types.StringValue(newsig.UpdatedAt)
+	state.UpdatedByID = types.Int64Value(int64(newsig.UpdatedByID))
+	state.CreatedAt = types.StringValue(newsig.CreatedAt)
+	state.CreatedByID = types.Int64Value(int64(newsig.CreatedByID))
+	if state.Note.IsNull() && newsig.Note == "" {
+		state.Note = types.StringNull()
+	} else {
+		state.Note = types.StringValue(newsig.Note)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceSignature) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Signature
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state Signature
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sigID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	updatedSig := &client.Signature{
+		ID:     sigID,
+		Name:   plan.Name.ValueString(),
+		Body:   plan.Body.ValueString(),
+		Note:   plan.Note.ValueString(),
+		Active: plan.Active.ValueBool(),
+	}
+
+	sig, err := r.client.UpdateSignature(updatedSig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating signature",
+			"Could not update signature "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	result := Signature{
+		ID:          types.StringValue(strconv.Itoa(sig.ID)),
+		Name:        types.StringValue(sig.Name),
+		Body:        types.StringValue(sig.Body),
+		Note:        types.StringValue(sig.Note),
+		Active:      types.BoolValue(sig.Active),
+		CreatedByID: types.Int64Value(int64(sig.CreatedByID)),
+		UpdatedByID: types.Int64Value(int64(sig.UpdatedByID)),
+		CreatedAt:   types.StringValue(sig.CreatedAt),
+		UpdatedAt:   types.StringValue(sig.UpdatedAt),
+	}
+	if plan.Note.IsNull() && sig.Note == "" {
+		result.Note = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceSignature) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Signature
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sigID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteSignature(&client.Signature{ID: sigID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting signature",
+			"Could not delete signature "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceSignature) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceSignature{}
+
+func TestAccBasicSignatureResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSignatureResourceConfig("one", "Best regards,\\n#{user.firstname} #{user.lastname}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_signature.test", "name", "one"),
+					resource.TestCheckResourceAttr("zammad_signature.test", "body", "Best regards,\n#{user.firstname} #{user.lastname}"),
+					resource.TestCheckResourceAttr("zammad_signature.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_signature.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSignatureResourceConfig("two", "#{config.product_name} Support"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_signature.test", "name", "two"),
+					resource.TestCheckResourceAttr("zammad_signature.test", "body", "#{config.product_name} Support"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInvalidPlaceholderSignatureResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSignatureResourceConfig("one", "#{agent.firstname}"),
+				ExpectError: regexp.MustCompile("Invalid placeholder"),
+			},
+			{
+				Config:      testAccSignatureResourceConfig("one", "#{user.firstname"),
+				ExpectError: regexp.MustCompile("Invalid placeholder"),
+			},
+		},
+	})
+}
+
+func testAccSignatureResourceConfig(name, body string) string {
+	return fmt.Sprintf(`
+resource "zammad_signature" "test" {
+	name = "%s"
+	body = "%s"
+}
+`, name, body)
+}
@@ -0,0 +1,419 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadSMIMECertificate() resource.Resource {
+	return &resourceSMIMECertificate{}
+}
+
+type resourceSMIMECertificate struct {
+	client *client.Client
+}
+
+// SMIMECertificate Resource schema
+func (r resourceSMIMECertificate) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "S/MIME certificate used by the S/MIME integration to sign and encrypt emails.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"certificate": schema.StringAttribute{
+				Required:      true,
+				Description:   "PEM encoded certificate. Zammad does not return it, after an import it is taken from the configuration.",
+				PlanModifiers: []planmodifier.String{requiresReplaceUnlessImported()},
+				Validators:    []validator.String{pemCertificateValidator{}},
+			},
+			"private_key": schema.StringAttribute{
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "PEM encoded private key of the certificate.",
+				PlanModifiers: []planmodifier.String{requiresReplaceUnlessImported()},
+			},
+			"private_key_secret": schema.StringAttribute{
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Passphrase of the private key.",
+				PlanModifiers: []planmodifier.String{requiresReplaceUnlessImported()},
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:      true,
+				Description:   "SHA1 fingerprint of the certificate.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"subject": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"email_addresses": schema.ListAttribute{
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Email addresses the certificate is valid for.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"not_before": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"not_after": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Expiry of the certificate.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"has_private_key": schema.BoolAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *resourceSMIMECertificate) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smime_certificate"
+}
+
+func (r *resourceSMIMECertificate) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceSMIMECertificate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan SMIMECertificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Zammad does not return the certificate it created, it is found
+	// again by its fingerprint.
+	fingerprint, err := smimeFingerprint(plan.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating S/MIME certificate",
+			"Could not parse certificate: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.AddSMIMECertificate(plan.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating S/MIME certificate",
+			"Could not create S/MIME certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !plan.PrivateKey.IsNull() {
+		err = r.client.AddSMIMEPrivateKey(plan.PrivateKey.ValueString(), plan.PrivateKeySecret.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating S/MIME certificate",
+				"Could not add private key, unexpected error: "+err.Error(),
+			)
+			// Remove the certificate again, it is not in the state and
+			// would fail the next upload as a duplicate.
+			cert, err := r.certificateByFingerprint(fingerprint)
+			if err == nil && cert != nil {
+				err = r.client.DeleteSMIMECertificate(cert)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error creating S/MIME certificate",
+					"Could not remove S/MIME certificate "+fingerprint+" after the private key failed: "+err.Error(),
+				)
+			}
+			return
+		}
+	}
+
+	cert, err := r.certificateByFingerprint(fingerprint)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating S/MIME certificate",
+			"Could not read S/MIME certificate "+fingerprint+": "+err.Error(),
+		)
+		return
+	}
+	if cert == nil {
+		resp.Diagnostics.AddError(
+			"Error creating S/MIME certificate",
+			"Could not find S/MIME certificate "+fingerprint+" after upload.",
+		)
+		return
+	}
+
+	result := smimeCertificateFromClient(cert, &plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceSMIMECertificate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SMIMECertificate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	cert, err := r.certificateByID(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading S/MIME certificate",
+			"Could not read S/MIME certificate "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if cert == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := smimeCertificateFromClient(cert, &state)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only adopts the certificate and private key from the
+// configuration, all other changes require replacement
+func (r resourceSMIMECertificate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SMIMECertificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fingerprint, err := smimeFingerprint(plan.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating S/MIME certificate",
+			"Could not parse certificate: "+err.Error(),
+		)
+		return
+	}
+	if fingerprint != normalizeFingerprint(state.Fingerprint.ValueString()) {
+		resp.Diagnostics.AddError(
+			"Error updating S/MIME certificate",
+			"The configured certificate "+fingerprint+" does not match the imported certificate "+state.Fingerprint.ValueString()+".",
+		)
+		return
+	}
+
+	if state.PrivateKey.IsNull() && !plan.PrivateKey.IsNull() && !state.HasPrivateKey.ValueBool() {
+		err = r.client.AddSMIMEPrivateKey(plan.PrivateKey.ValueString(), plan.PrivateKeySecret.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating S/MIME certificate",
+				"Could not add private key, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	cert, err := r.certificateByID(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating S/MIME certificate",
+			"Could not read S/MIME certificate "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if cert == nil {
+		resp.Diagnostics.AddError(
+			"Error updating S/MIME certificate",
+			"Could not find S/MIME certificate "+state.ID.ValueString()+".",
+		)
+		return
+	}
+
+	result := smimeCertificateFromClient(cert, &plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceSMIMECertificate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SMIMECertificate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteSMIMECertificate(&client.SMIMECertificate{ID: id})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting S/MIME certificate",
+			"Could not delete S/MIME certificate "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceSMIMECertificate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// certificateByID returns the certificate with the given ID, or nil if it does
+// not exist. Zammad only lists certificates.
+func (r resourceSMIMECertificate) certificateByID(id int) (*client.SMIMECertificate, error) {
+	certs, err := r.client.GetSMIMECertificates()
+	if err != nil {
+		return nil, err
+	}
+	for i := range certs {
+		if certs[i].ID == id {
+			return &certs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r resourceSMIMECertificate) certificateByFingerprint(fingerprint string) (*client.SMIMECertificate, error) {
+	certs, err := r.client.GetSMIMECertificates()
+	if err != nil {
+		return nil, err
+	}
+	for i := range certs {
+		if normalizeFingerprint(certs[i].Fingerprint) == fingerprint {
+			return &certs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// requiresReplaceUnlessImported requires replacement when key material
+// changes, except when it is set for the first time after an import, as
+// Zammad never returns it.
+func requiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Requires replacement unless the value was not known before, e.g. after an import.",
+		"Requires replacement unless the value was not known before, e.g. after an import.",
+	)
+}
+
+// smimeFingerprint returns the SHA1 fingerprint of the first certificate in
+// data, the way Zammad computes it.
+func smimeFingerprint(data string) (string, error) {
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return "", errors.New("no PEM encoded certificate found")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return "", err
+		}
+		sum := sha1.Sum(block.Bytes)
+		return hex.EncodeToString(sum[:]), nil
+	}
+}
+
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+}
+
+func smimeCertificateFromClient(cert *client.SMIMECertificate, prior *SMIMECertificate) *SMIMECertificate {
+	return &SMIMECertificate{
+		ID:               types.StringValue(strconv.Itoa(cert.ID)),
+		Certificate:      prior.Certificate,
+		PrivateKey:       prior.PrivateKey,
+		PrivateKeySecret: prior.PrivateKeySecret,
+		Fingerprint:      types.StringValue(cert.Fingerprint),
+		Subject:          types.StringValue(cert.Subject),
+		EmailAddresses:   stringListValue(cert.EmailAddresses),
+		NotBefore:        types.StringValue(cert.NotBeforeAt),
+		NotAfter:         types.StringValue(cert.NotAfterAt),
+		HasPrivateKey:    types.BoolValue(cert.PrivateKey != ""),
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceSMIMECertificate{}
+
+func TestAccBasicSMIMECertificateResource(t *testing.T) {
+	cert, key := testAccSMIMECertificate(t, "smime@example.com", 90*24*time.Hour)
+	fingerprint, err := smimeFingerprint(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSMIMECertificateResourceConfig(cert, key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_smime_certificate.test", "fingerprint", fingerprint),
+					resource.TestCheckResourceAttr("zammad_smime_certificate.test", "email_addresses.#", "1"),
+					resource.TestCheckResourceAttr("zammad_smime_certificate.test", "email_addresses.0", "smime@example.com"),
+					resource.TestCheckResourceAttr("zammad_smime_certificate.test", "has_private_key", "true"),
+					resource.TestCheckResourceAttrSet("zammad_smime_certificate.test", "not_after"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "zammad_smime_certificate.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "private_key", "private_key_secret"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSMIMECertificateResourceConfig(cert, key string) string {
+	return fmt.Sprintf(`
+resource "zammad_smime_certificate" "test" {
+	certificate = <<EOT
+%sEOT
+	private_key = <<EOT
+%sEOT
+}
+`, cert, key)
+}
+
+// testAccSMIMECertificate returns a self-signed PEM encoded certificate and
+// private key for email, valid for the given duration.
+func testAccSMIMECertificate(t *testing.T, email string, validity time.Duration) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(time.Now().UnixNano()),
+		Subject:        pkix.Name{CommonName: email},
+		EmailAddresses: []string{email},
+		NotBefore:      time.Now().Add(-time.Hour),
+		NotAfter:       time.Now().Add(validity),
+		KeyUsage:       x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	priv := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return string(cert), string(priv)
+}
@@ -0,0 +1,336 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// templateArticleAttributes are the article fields a template can pre-fill.
+var templateArticleAttributes = []string{"body", "subject", "type_id", "sender_id", "internal", "to", "cc"}
+
+func NewZammadTemplate() resource.Resource {
+	return &resourceTemplate{}
+}
+
+type resourceTemplate struct {
+	client *client.Client
+}
+
+// Template Resource schema
+func (r resourceTemplate) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"options": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Values to pre-fill, keyed by ticket or article attribute, e.g. ticket.title, ticket.group_id or article.body. IDs are given as strings, tags as a comma separated list.",
+				Validators: []validator.Map{mapKeysMatchValidator{
+					re:      regexp.MustCompile(`^(ticket\.[a-z0-9_]+|article\.(` + strings.Join(templateArticleAttributes, "|") + `))$`),
+					message: "a ticket attribute such as ticket.title or one of article." + strings.Join(templateArticleAttributes, ", article."),
+				}},
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceTemplate) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template"
+}
+
+func (r *resourceTemplate) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ModifyPlan warns about ticket attributes unknown to the object manager.
+// This is not an error, as the attribute might be created in the same apply.
+func (r resourceTemplate) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var options types.Map
+	diags := req.Plan.GetAttribute(ctx, path.Root("options"), &options)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || options.IsNull() || options.IsUnknown() {
+		return
+	}
+
+	attrs, err := r.client.GetObjectManagerAttributes()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not validate template options",
+			"Could not read ticket attributes: "+err.Error(),
+		)
+		return
+	}
+	known := map[string]bool{"tags": true}
+	for _, a := range attrs {
+		if a.Object == "Ticket" {
+			known[a.Name] = true
+		}
+	}
+
+	for key := range options.Elements() {
+		if name := strings.TrimPrefix(key, "ticket."); name != key && !known[name] {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("options").AtMapKey(key),
+				"Unknown ticket attribute",
+				fmt.Sprintf("Zammad has no ticket attribute %q.", name),
+			)
+		}
+	}
+}
+
+// Create a new resource
+func (r resourceTemplate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan Template
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, diags := templateOptionsToClient(ctx, plan.Options)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tpl, err := r.client.CreateTemplate(&client.Template{
+		Name:    plan.Name.ValueString(),
+		Options: options,
+		Active:  plan.Active.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating template",
+			"Could not create template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, templateFromClient(tpl))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceTemplate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Template
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tplID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	tpl, err := r.client.GetTemplate(tplID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading template",
+			"Could not read template "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, templateFromClient(tpl))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Template
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state Template
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tplID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	options, diags := templateOptionsToClient(ctx, plan.Options)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tpl, err := r.client.UpdateTemplate(&client.Template{
+		ID:      tplID,
+		Name:    plan.Name.ValueString(),
+		Options: options,
+		Active:  plan.Active.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating template",
+			"Could not update template "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, templateFromClient(tpl))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceTemplate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Template
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tplID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteTemplate(&client.Template{ID: tplID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting template",
+			"Could not delete template "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceTemplate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func templateOptionsToClient(ctx context.Context, options types.Map) (map[string]map[string]interface{}, diag.Diagnostics) {
+	values := map[string]string{}
+	diags := options.ElementsAs(ctx, &values, false)
+	result := make(map[string]map[string]interface{}, len(values))
+	for key, value := range values {
+		result[key] = map[string]interface{}{"value": value}
+	}
+	return result, diags
+}
+
+func templateFromClient(tpl *client.Template) Template {
+	options := make(map[string]attr.Value, len(tpl.Options))
+	for key, option := range tpl.Options {
+		var value string
+		switch v := option["value"].(type) {
+		case []interface{}:
+			values := make([]string, len(v))
+			for i := range v {
+				values[i] = jsonScalarString(v[i])
+			}
+			value = strings.Join(values, ", ")
+		default:
+			value = jsonScalarString(v)
+		}
+		options[key] = types.StringValue(value)
+	}
+	return Template{
+		ID:          types.StringValue(strconv.Itoa(tpl.ID)),
+		Name:        types.StringValue(tpl.Name),
+		Options:     types.MapValueMust(types.StringType, options),
+		Active:      types.BoolValue(tpl.Active),
+		CreatedByID: types.Int64Value(int64(tpl.CreatedByID)),
+		UpdatedByID: types.Int64Value(int64(tpl.UpdatedByID)),
+		CreatedAt:   types.StringValue(tpl.CreatedAt),
+		UpdatedAt:   types.StringValue(tpl.UpdatedAt),
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceTemplate{}
+
+func TestAccBasicTemplateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTemplateResourceConfig("one", "ticket.title", "Password reset"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_template.test", "name", "one"),
+					resource.TestCheckResourceAttr("zammad_template.test", "options.ticket.title", "Password reset"),
+					resource.TestCheckResourceAttr("zammad_template.test", "options.ticket.priority_id", "2"),
+					resource.TestCheckResourceAttr("zammad_template.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTemplateResourceConfig("two", "article.body", "Please reset my password."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_template.test", "name", "two"),
+					resource.TestCheckResourceAttr("zammad_template.test", "options.%", "2"),
+					resource.TestCheckResourceAttr("zammad_template.test", "options.article.body", "Please reset my password."),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInvalidOptionTemplateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTemplateResourceConfig("one", "article.title", "x"),
+				ExpectError: regexp.MustCompile("Invalid key"),
+			},
+		},
+	})
+}
+
+func testAccTemplateResourceConfig(name, key, value string) string {
+	return fmt.Sprintf(`
+resource "zammad_template" "test" {
+	name = "%s"
+	options = {
+		"ticket.priority_id" = "2"
+		"%s" = "%s"
+	}
+}
+`, name, key, value)
+}
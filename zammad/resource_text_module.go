@@ -0,0 +1,366 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadTextModule() resource.Resource {
+	return &resourceTextModule{}
+}
+
+type resourceTextModule struct {
+	client *client.Client
+}
+
+// Text Module Resource schema
+func (r resourceTextModule) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"keywords": schema.StringAttribute{
+				Optional:    true,
+				Description: "Keywords used to find the text module.",
+			},
+			"content": schema.StringAttribute{
+				Required:    true,
+				Description: "Content of the text module, in the format given by content_format.",
+				Validators: []validator.String{placeholderValidator{
+					objects: []string{"ticket", "user", "config"},
+				}},
+			},
+			"content_format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Format of content, html (default) or markdown. Markdown is converted to HTML before it is sent to Zammad.",
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{contentFormatHTML, contentFormatMarkdown},
+				}},
+			},
+			"group_ids": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Groups in which the text module is available. Available in all groups if empty.",
+			},
+			"note": schema.StringAttribute{
+				Optional: true,
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceTextModule) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_text_module"
+}
+
+func (r *resourceTextModule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceTextModule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan TextModule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := contentToHTML(plan.Content.ValueString(), plan.ContentFormat.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting content",
+			"Could not convert content to HTML: "+err.Error(),
+		)
+		return
+	}
+
+	groups := make([]int, 0)
+	resp.Diagnostics.Append(plan.GroupIDs.ElementsAs(ctx, &groups, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tmreq := &client.TextModule{
+		Name:     plan.Name.ValueString(),
+		Keywords: plan.Keywords.ValueString(),
+		Content:  content,
+		GroupIDs: groups,
+		Note:     plan.Note.ValueString(),
+		Active:   plan.Active.ValueBool(),
+	}
+
+	tm, err := r.client.CreateTextModule(tmreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating text_module",
+			"Could not create text_module, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := TextModule{
+		ID:            types.StringValue(strconv.Itoa(tm.ID)),
+		Name:          types.StringValue(tm.Name),
+		Keywords:      types.StringValue(tm.Keywords),
+		Content:       plan.Content,
+		ContentFormat: plan.ContentFormat,
+		GroupIDs:      int64SetValue(tm.GroupIDs),
+		Note:          types.StringValue(tm.Note),
+		Active:        types.BoolValue(tm.Active),
+		CreatedByID:   types.Int64Value(int64(tm.CreatedByID)),
+		UpdatedByID:   types.Int64Value(int64(tm.UpdatedByID)),
+		CreatedAt:     types.StringValue(tm.CreatedAt),
+		UpdatedAt:     types.StringValue(tm.UpdatedAt),
+	}
+	if plan.Keywords.IsNull() && tm.Keywords == "" {
+		result.Keywords = types.StringNull()
+	}
+	if plan.GroupIDs.IsNull() && len(tm.GroupIDs) == 0 {
+		result.GroupIDs = types.SetNull(types.Int64Type)
+	}
+	if plan.Note.IsNull() && tm.Note == "" {
+		result.Note = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceTextModule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TextModule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tmID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	newtm, err := r.client.GetTextModule(tmID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading text_module",
+			"Could not read text_module "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(newtm.Name)
+	state.Content = types.StringValue(contentFromHTML(newtm.Content, state.Content.ValueString(), state.ContentFormat.ValueString()))
+	state.Active = types.BoolValue(newtm.Active)
+	state.UpdatedAt = types.StringValue(newtm.UpdatedAt)
+	state.UpdatedByID = types.Int64Value(int64(newtm.UpdatedByID))
+	state.CreatedAt = types.StringValue(newtm.CreatedAt)
+	state.CreatedByID = types.Int64Value(int64(newtm.CreatedByID))
+	if state.Keywords.IsNull() && newtm.Keywords == "" {
+		state.Keywords = types.StringNull()
+	} else {
+		state.Keywords = types.StringValue(newtm.Keywords)
+	}
+	if state.GroupIDs.IsNull() && len(newtm.GroupIDs) == 0 {
+		state.GroupIDs = types.SetNull(types.Int64Type)
+	} else {
+		state.GroupIDs = int64SetValue(newtm.GroupIDs)
+	}
+	if state.Note.IsNull() && newtm.Note == "" {
+		state.Note = types.StringNull()
+	} else {
+		state.Note = types.StringValue(newtm.Note)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceTextModule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TextModule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state TextModule
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tmID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	content, err := contentToHTML(plan.Content.ValueString(), plan.ContentFormat.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting content",
+			"Could not convert content to HTML: "+err.Error(),
+		)
+		return
+	}
+
+	groups := make([]int, 0)
+	resp.Diagnostics.Append(plan.GroupIDs.ElementsAs(ctx, &groups, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedTM := &client.TextModule{
+		ID:       tmID,
+		Name:     plan.Name.ValueString(),
+		Keywords: plan.Keywords.ValueString(),
+		Content:  content,
+		GroupIDs: groups,
+		Note:     plan.Note.ValueString(),
+		Active:   plan.Active.ValueBool(),
+	}
+
+	tm, err := r.client.UpdateTextModule(updatedTM)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating text_module",
+			"Could not update text_module "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	result := TextModule{
+		ID:            types.StringValue(strconv.Itoa(tm.ID)),
+		Name:          types.StringValue(tm.Name),
+		Keywords:      types.StringValue(tm.Keywords),
+		Content:       plan.Content,
+		ContentFormat: plan.ContentFormat,
+		GroupIDs:      int64SetValue(tm.GroupIDs),
+		Note:          types.StringValue(tm.Note),
+		Active:        types.BoolValue(tm.Active),
+		CreatedByID:   types.Int64Value(int64(tm.CreatedByID)),
+		UpdatedByID:   types.Int64Value(int64(tm.UpdatedByID)),
+		CreatedAt:     types.StringValue(tm.CreatedAt),
+		UpdatedAt:     types.StringValue(tm.UpdatedAt),
+	}
+	if plan.Keywords.IsNull() && tm.Keywords == "" {
+		result.Keywords = types.StringNull()
+	}
+	if plan.GroupIDs.IsNull() && len(tm.GroupIDs) == 0 {
+		result.GroupIDs = types.SetNull(types.Int64Type)
+	}
+	if plan.Note.IsNull() && tm.Note == "" {
+		result.Note = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceTextModule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TextModule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tmID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteTextModule(&client.TextModule{ID: tmID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting text_module",
+			"Could not delete text_module "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceTextModule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
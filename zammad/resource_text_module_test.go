@@ -0,0 +1,106 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceTextModule{}
+
+func TestAccBasicTextModuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTextModuleResourceConfig("one", "<div>Hello #{ticket.customer.firstname}</div>"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_text_module.test", "name", "one"),
+					resource.TestCheckResourceAttr("zammad_text_module.test", "content", "<div>Hello #{ticket.customer.firstname}</div>"),
+					resource.TestCheckResourceAttr("zammad_text_module.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_text_module.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTextModuleResourceConfig("two", "<div>Bye</div>"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_text_module.test", "name", "two"),
+					resource.TestCheckResourceAttr("zammad_text_module.test", "content", "<div>Bye</div>"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMarkdownTextModuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMarkdownTextModuleResourceConfig("one", "hello", "Hello **#{ticket.customer.firstname}**,\\n\\nThanks."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_text_module.test", "keywords", "hello"),
+					resource.TestCheckResourceAttr("zammad_text_module.test", "content", "Hello **#{ticket.customer.firstname}**,\n\nThanks."),
+					resource.TestCheckResourceAttr("zammad_text_module.test", "content_format", "markdown"),
+					resource.TestCheckResourceAttr("zammad_text_module.test", "group_ids.#", "1"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccMarkdownTextModuleResourceConfig("one", "bye", "- Bye\\n- Cheers"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_text_module.test", "keywords", "bye"),
+					resource.TestCheckResourceAttr("zammad_text_module.test", "content", "- Bye\n- Cheers"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTextModuleResourceConfig(name, content string) string {
+	return fmt.Sprintf(`
+resource "zammad_text_module" "test" {
+	name = "%s"
+	content = "%s"
+}
+`, name, content)
+}
+
+func testAccMarkdownTextModuleResourceConfig(name, keywords, content string) string {
+	return fmt.Sprintf(`
+resource "zammad_text_module" "test" {
+	name = "%s"
+	keywords = "%s"
+	content = "%s"
+	content_format = "markdown"
+	group_ids = [1]
+}
+`, name, keywords, content)
+}
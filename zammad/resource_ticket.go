@@ -0,0 +1,493 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+const (
+	ticketArticleDefaultType        = "note"
+	ticketArticleDefaultContentType = "text/plain"
+)
+
+func NewZammadTicket() resource.Resource {
+	return &resourceTicket{}
+}
+
+type resourceTicket struct {
+	client *client.Client
+}
+
+// Ticket Resource schema
+func (r resourceTicket) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"number": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"title": schema.StringAttribute{
+				Required: true,
+			},
+			"group_id": schema.Int64Attribute{
+				Required: true,
+			},
+			"customer_id": schema.Int64Attribute{
+				Required: true,
+			},
+			"organization_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Organization of the ticket. Defaults to the organization of the customer.",
+			},
+			"state_id": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"priority_id": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"owner_id": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"article": schema.SingleNestedAttribute{
+				Required:      true,
+				Description:   "The article the ticket is created with. Changing the article creates a new ticket.",
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.RequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"subject": schema.StringAttribute{
+						Optional: true,
+					},
+					"body": schema.StringAttribute{
+						Required: true,
+					},
+					"type": schema.StringAttribute{
+						Optional:    true,
+						Description: "Type of the article, e.g. note, email or phone. Defaults to note.",
+					},
+					"content_type": schema.StringAttribute{
+						Optional:    true,
+						Description: "Content type of the body, text/plain (default) or text/html.",
+						Validators: []validator.String{stringOneOfValidator{
+							values: []string{"text/plain", "text/html"},
+						}},
+					},
+					"internal": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+			},
+			"close_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Close the ticket instead of deleting it on destroy.",
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceTicket) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket"
+}
+
+func (r *resourceTicket) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceTicket) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan Ticket
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	diags = plan.Tags.ElementsAs(ctx, &tags, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	treq := ticketToClient(plan)
+	treq.Tags = strings.Join(tags, ",")
+	treq.Article = &client.TicketArticle{
+		Subject:     plan.Article.Subject.ValueString(),
+		Body:        plan.Article.Body.ValueString(),
+		Type:        ticketArticleDefaultType,
+		ContentType: ticketArticleDefaultContentType,
+		Internal:    plan.Article.Internal.ValueBool(),
+	}
+	if !plan.Article.Type.IsNull() {
+		treq.Article.Type = plan.Article.Type.ValueString()
+	}
+	if !plan.Article.ContentType.IsNull() {
+		treq.Article.ContentType = plan.Article.ContentType.ValueString()
+	}
+
+	t, err := r.client.CreateTicket(treq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ticket",
+			"Could not create ticket, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result, diags := r.ticketFromClient(t, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceTicket) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Ticket
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	t, err := r.client.GetTicket(tID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ticket",
+			"Could not read ticket "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The initial article is only read on import, it can not change.
+	if state.Article == nil {
+		articles, err := r.client.GetTicketArticles(tID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading ticket articles",
+				"Could not read articles of ticket "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if len(articles) > 0 {
+			state.Article = ticketInitialArticleFromClient(&articles[0])
+		}
+	}
+
+	result, diags := r.ticketFromClient(t, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceTicket) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Ticket
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state Ticket
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	updatedTicket := ticketToClient(plan)
+	updatedTicket.ID = tID
+
+	t, err := r.client.UpdateTicket(updatedTicket)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ticket",
+			"Could not update ticket "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var planTags, stateTags []string
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &planTags, true)...)
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &stateTags, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, tag := range stateTags {
+		if stringInSlice(tag, planTags) {
+			continue
+		}
+		if err := r.client.RemoveObjectTag("Ticket", tID, tag); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating ticket tags",
+				"Could not remove tag "+tag+" from ticket "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+	for _, tag := range planTags {
+		if stringInSlice(tag, stateTags) {
+			continue
+		}
+		if err := r.client.AddObjectTag("Ticket", tID, tag); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating ticket tags",
+				"Could not add tag "+tag+" to ticket "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	result, diags := r.ticketFromClient(t, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceTicket) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Ticket
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if !state.CloseOnDestroy.ValueBool() {
+		err = r.client.DeleteTicket(&client.Ticket{ID: tID})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting ticket",
+				"Could not delete ticket "+state.ID.ValueString()+": "+err.Error(),
+			)
+		}
+		return
+	}
+
+	states, err := r.client.GetTicketStates()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error closing ticket",
+			"Could not read ticket states: "+err.Error(),
+		)
+		return
+	}
+	closedID := 0
+	for _, s := range states {
+		if s.Name == "closed" {
+			closedID = s.ID
+		}
+	}
+	if closedID == 0 {
+		resp.Diagnostics.AddError(
+			"Error closing ticket",
+			"Could not find the ticket state closed.",
+		)
+		return
+	}
+
+	closedTicket := ticketToClient(state)
+	closedTicket.ID = tID
+	closedTicket.StateID = closedID
+	_, err = r.client.UpdateTicket(closedTicket)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error closing ticket",
+			"Could not close ticket "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceTicket) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func ticketToClient(plan Ticket) *client.Ticket {
+	return &client.Ticket{
+		Title:          plan.Title.ValueString(),
+		GroupID:        int(plan.GroupID.ValueInt64()),
+		CustomerID:     int(plan.CustomerID.ValueInt64()),
+		OrganizationID: int64PointerValue(plan.OrganizationID),
+		StateID:        int(plan.StateID.ValueInt64()),
+		PriorityID:     int(plan.PriorityID.ValueInt64()),
+		OwnerID:        int(plan.OwnerID.ValueInt64()),
+	}
+}
+
+// ticketFromClient converts a ticket returned by Zammad and reads its tags.
+// The article and close_on_destroy are taken from prior.
+func (r resourceTicket) ticketFromClient(t *client.Ticket, prior Ticket) (Ticket, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := Ticket{
+		ID:             types.StringValue(strconv.Itoa(t.ID)),
+		Number:         types.StringValue(t.Number),
+		Title:          types.StringValue(t.Title),
+		GroupID:        types.Int64Value(int64(t.GroupID)),
+		CustomerID:     types.Int64Value(int64(t.CustomerID)),
+		OrganizationID: int64PointerToValue(t.OrganizationID),
+		StateID:        types.Int64Value(int64(t.StateID)),
+		PriorityID:     types.Int64Value(int64(t.PriorityID)),
+		OwnerID:        types.Int64Value(int64(t.OwnerID)),
+		Article:        prior.Article,
+		CloseOnDestroy: prior.CloseOnDestroy,
+		CreatedByID:    types.Int64Value(int64(t.CreatedByID)),
+		UpdatedByID:    types.Int64Value(int64(t.UpdatedByID)),
+		CreatedAt:      types.StringValue(t.CreatedAt),
+		UpdatedAt:      types.StringValue(t.UpdatedAt),
+	}
+
+	tags, err := r.client.GetObjectTags("Ticket", t.ID)
+	if err != nil {
+		diags.AddError(
+			"Error reading ticket tags",
+			"Could not read tags of ticket "+strconv.Itoa(t.ID)+": "+err.Error(),
+		)
+		return result, diags
+	}
+	if prior.Tags.IsNull() && len(tags) == 0 {
+		result.Tags = types.SetNull(types.StringType)
+	} else {
+		sort.Strings(tags)
+		elems := make([]attr.Value, len(tags))
+		for i := range tags {
+			elems[i] = types.StringValue(tags[i])
+		}
+		result.Tags = types.SetValueMust(types.StringType, elems)
+	}
+
+	return result, diags
+}
+
+// ticketInitialArticleFromClient converts the first article of an imported
+// ticket. Attributes with their default value are left null.
+func ticketInitialArticleFromClient(a *client.TicketArticle) *TicketInitialArticle {
+	article := &TicketInitialArticle{
+		Subject:     types.StringValue(a.Subject),
+		Body:        types.StringValue(a.Body),
+		Type:        types.StringValue(a.Type),
+		ContentType: types.StringValue(a.ContentType),
+		Internal:    types.BoolValue(a.Internal),
+	}
+	if a.Subject == "" {
+		article.Subject = types.StringNull()
+	}
+	if a.Type == ticketArticleDefaultType {
+		article.Type = types.StringNull()
+	}
+	if a.ContentType == ticketArticleDefaultContentType {
+		article.ContentType = types.StringNull()
+	}
+	if !a.Internal {
+		article.Internal = types.BoolNull()
+	}
+	return article
+}
@@ -0,0 +1,243 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadTicketLink() resource.Resource {
+	return &resourceTicketLink{}
+}
+
+type resourceTicketLink struct {
+	client *client.Client
+}
+
+// TicketLink Resource schema
+func (r resourceTicketLink) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The link as <link_object_target_value>:<link_type>:<link_object_source_number>, also used for import.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"link_type": schema.StringAttribute{
+				Required:      true,
+				Description:   "Type of the link: normal, parent or child.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{"normal", "parent", "child"},
+				}},
+			},
+			"link_object_source_number": schema.StringAttribute{
+				Required:      true,
+				Description:   "Number of the ticket which is linked.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"link_object_target_value": schema.Int64Attribute{
+				Required:      true,
+				Description:   "ID of the ticket the link is added to.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *resourceTicketLink) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_link"
+}
+
+func (r *resourceTicketLink) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceTicketLink) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan TicketLink
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddLink(&client.Link{
+		LinkType:               plan.LinkType.ValueString(),
+		LinkObjectSource:       "Ticket",
+		LinkObjectSourceNumber: plan.LinkObjectSourceNumber.ValueString(),
+		LinkObjectTarget:       "Ticket",
+		LinkObjectTargetValue:  int(plan.LinkObjectTargetValue.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ticket link",
+			"Could not create ticket link, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d:%s:%s", plan.LinkObjectTargetValue.ValueInt64(), plan.LinkType.ValueString(), plan.LinkObjectSourceNumber.ValueString()))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceTicketLink) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TicketLink
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.SplitN(state.ID.ValueString(), ":", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could not parse id "+state.ID.ValueString()+", expected <link_object_target_value>:<link_type>:<link_object_source_number>",
+		)
+		return
+	}
+	targetID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	state.LinkObjectTargetValue = types.Int64Value(int64(targetID))
+	state.LinkType = types.StringValue(parts[1])
+	state.LinkObjectSourceNumber = types.StringValue(parts[2])
+
+	sourceID, err := r.linkedTicketID(targetID, parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ticket link",
+			"Could not read links of ticket "+parts[0]+": "+err.Error(),
+		)
+		return
+	}
+	if sourceID == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, all attributes require replacement
+func (r resourceTicketLink) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Error updating ticket link",
+		"Ticket links can not be updated.",
+	)
+}
+
+// Delete resource
+func (r resourceTicketLink) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TicketLink
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID, err := r.linkedTicketID(int(state.LinkObjectTargetValue.ValueInt64()), state.LinkType.ValueString(), state.LinkObjectSourceNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ticket link",
+			"Could not read links of ticket "+strconv.FormatInt(state.LinkObjectTargetValue.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+	// The link was already removed.
+	if sourceID == 0 {
+		return
+	}
+
+	err = r.client.RemoveLink(&client.Link{
+		LinkType:              state.LinkType.ValueString(),
+		LinkObjectSource:      "Ticket",
+		LinkObjectSourceValue: sourceID,
+		LinkObjectTarget:      "Ticket",
+		LinkObjectTargetValue: int(state.LinkObjectTargetValue.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ticket link",
+			"Could not delete ticket link "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceTicketLink) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// linkedTicketID returns the ID of the ticket with the given number which is
+// linked to the target ticket with the given link type, or 0 if there is no
+// such link. Links are added by number but removed and listed by ID, the
+// numbers of the linked tickets are read directly as the search index may not
+// contain new tickets yet.
+func (r resourceTicketLink) linkedTicketID(targetID int, linkType, number string) (int, error) {
+	links, err := r.client.GetLinks("Ticket", targetID)
+	if err != nil {
+		return 0, err
+	}
+	for _, l := range links {
+		if l.LinkObject != "Ticket" || l.LinkType != linkType {
+			continue
+		}
+		ticket, err := r.client.GetTicket(l.LinkObjectValue)
+		if err != nil {
+			return 0, err
+		}
+		if ticket.Number == number {
+			return ticket.ID, nil
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceTicketLink{}
+
+func TestAccBasicTicketLinkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTicketLinkResourceConfig("normal"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_ticket_link.test", "link_type", "normal"),
+					resource.TestCheckResourceAttrPair("zammad_ticket_link.test", "link_object_source_number", "zammad_ticket.problem", "number"),
+					resource.TestCheckResourceAttrPair("zammad_ticket_link.test", "link_object_target_value", "zammad_ticket.incident", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_ticket_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace testing
+			{
+				Config: testAccTicketLinkResourceConfig("parent"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_ticket_link.test", "link_type", "parent"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTicketLinkResourceConfig(linkType string) string {
+	return fmt.Sprintf(`
+resource "zammad_ticket" "problem" {
+	title       = "Problem"
+	group_id    = 1
+	customer_id = 1
+	article = {
+		body = "Root cause analysis."
+	}
+}
+
+resource "zammad_ticket" "incident" {
+	title       = "Incident"
+	group_id    = 1
+	customer_id = 1
+	article = {
+		body = "Service unavailable."
+	}
+}
+
+resource "zammad_ticket_link" "test" {
+	link_type                 = "%s"
+	link_object_source_number = zammad_ticket.problem.number
+	link_object_target_value  = zammad_ticket.incident.id
+}
+`, linkType)
+}
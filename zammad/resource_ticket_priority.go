@@ -346,3 +346,24 @@ func (m defaultTrue) PlanModifyBool(ctx context.Context, req planmodifier.BoolRe
 
 	resp.PlanValue = types.BoolValue(true)
 }
+
+// defaultString plans a value for string attributes which are not
+// configured.
+type defaultString struct {
+	value string
+}
+
+func (m defaultString) Description(ctx context.Context) string {
+	return "If value is not configured, defaults to " + m.value
+}
+
+func (m defaultString) MarkdownDescription(ctx context.Context) string {
+	return "If value is not configured, defaults to `" + m.value + "`"
+}
+
+func (m defaultString) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	resp.PlanValue = types.StringValue(m.value)
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceTicket{}
+
+func TestAccBasicTicketResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTicketResourceConfig("Runbook", `["runbook"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_ticket.test", "title", "Runbook"),
+					resource.TestCheckResourceAttr("zammad_ticket.test", "group_id", "1"),
+					resource.TestCheckResourceAttr("zammad_ticket.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("zammad_ticket.test", "article.body", "Steps to follow."),
+					resource.TestCheckResourceAttrSet("zammad_ticket.test", "number"),
+					resource.TestCheckResourceAttrSet("zammad_ticket.test", "state_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "zammad_ticket.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"close_on_destroy"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccTicketResourceConfig("Known issues", `["known-issue", "tracker"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_ticket.test", "title", "Known issues"),
+					resource.TestCheckResourceAttr("zammad_ticket.test", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr("zammad_ticket.test", "tags.*", "tracker"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTicketResourceConfig(title, tags string) string {
+	return fmt.Sprintf(`
+resource "zammad_ticket" "test" {
+	title            = "%s"
+	group_id         = 1
+	customer_id      = 1
+	tags             = %s
+	close_on_destroy = true
+	article = {
+		subject = "Runbook"
+		body    = "Steps to follow."
+	}
+}
+`, title, tags)
+}
@@ -0,0 +1,240 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+const timeAccountingSettingsID = "time_accounting"
+
+// timeAccountingSelector is the value of the time_accounting_selector
+// setting.
+type timeAccountingSelector struct {
+	Condition map[string]client.Condition `json:"condition"`
+}
+
+func NewZammadTimeAccountingSettings() resource.Resource {
+	return &resourceTimeAccountingSettings{}
+}
+
+type resourceTimeAccountingSettings struct {
+	client *client.Client
+}
+
+// TimeAccountingSettings Resource schema
+func (r resourceTimeAccountingSettings) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable time accounting. Defaults to true, time accounting is disabled on destroy.",
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"selector": conditionSchema("Only ask for time accounting on tickets matching these conditions, keyed by attribute.", false),
+			"unit": schema.StringAttribute{
+				Optional:    true,
+				Description: "Unit of the accounted time: hour, quarter, minute or custom.",
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{"hour", "quarter", "minute", "custom"},
+				}},
+			},
+			"unit_custom": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the unit if unit is custom.",
+			},
+			"types": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Let agents pick an activity type when accounting time.",
+			},
+		},
+	}
+}
+
+func (r *resourceTimeAccountingSettings) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_time_accounting_settings"
+}
+
+func (r *resourceTimeAccountingSettings) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create applies the settings
+func (r resourceTimeAccountingSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan TimeAccountingSettings
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceTimeAccountingSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TimeAccountingSettings
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSettingsByName(timeAccountingSettingNames...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading time accounting settings",
+			"Could not read time accounting settings: "+err.Error(),
+		)
+		return
+	}
+
+	result, diags := timeAccountingSettingsFromClient(settings, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceTimeAccountingSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TimeAccountingSettings
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete disables time accounting and resets the other settings
+func (r resourceTimeAccountingSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	_, diags := r.apply(TimeAccountingSettings{
+		Enabled:    types.BoolValue(false),
+		Unit:       types.StringNull(),
+		UnitCustom: types.StringNull(),
+		Types:      types.BoolNull(),
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Import resource
+func (r resourceTimeAccountingSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+var timeAccountingSettingNames = []string{
+	"time_accounting",
+	"time_accounting_selector",
+	"time_accounting_unit",
+	"time_accounting_unit_custom",
+	"time_accounting_types",
+}
+
+// apply writes plan to the settings and returns the new state.
+func (r resourceTimeAccountingSettings) apply(plan TimeAccountingSettings) (TimeAccountingSettings, diag.Diagnostics) {
+	settings, diags := updateSettings(r.client, "time accounting settings",
+		settingValue{"time_accounting", plan.Enabled.ValueBool()},
+		settingValue{"time_accounting_selector", timeAccountingSelector{Condition: conditionsToClient(plan.Selector)}},
+		settingValue{"time_accounting_unit", plan.Unit.ValueString()},
+		settingValue{"time_accounting_unit_custom", plan.UnitCustom.ValueString()},
+		settingValue{"time_accounting_types", plan.Types.ValueBool()},
+	)
+	if diags.HasError() {
+		return plan, diags
+	}
+	return timeAccountingSettingsFromClient(settings, plan)
+}
+
+func timeAccountingSettingsFromClient(settings map[string]*client.Setting, prior TimeAccountingSettings) (TimeAccountingSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var enabled, typesEnabled bool
+	var unit, unitCustom string
+	var selector timeAccountingSelector
+	decodeSetting(settings["time_accounting"], &enabled, &diags)
+	decodeSetting(settings["time_accounting_selector"], &selector, &diags)
+	decodeSetting(settings["time_accounting_unit"], &unit, &diags)
+	decodeSetting(settings["time_accounting_unit_custom"], &unitCustom, &diags)
+	decodeSetting(settings["time_accounting_types"], &typesEnabled, &diags)
+
+	result := TimeAccountingSettings{
+		ID:         types.StringValue(timeAccountingSettingsID),
+		Enabled:    types.BoolValue(enabled),
+		Selector:   conditionsFromClient(selector.Condition),
+		Unit:       types.StringValue(unit),
+		UnitCustom: types.StringValue(unitCustom),
+		Types:      types.BoolValue(typesEnabled),
+	}
+	if prior.Selector != nil && result.Selector == nil {
+		result.Selector = map[string]Condition{}
+	}
+	if prior.Unit.IsNull() && unit == "" {
+		result.Unit = types.StringNull()
+	}
+	if prior.UnitCustom.IsNull() && unitCustom == "" {
+		result.UnitCustom = types.StringNull()
+	}
+	if prior.Types.IsNull() && !typesEnabled {
+		result.Types = types.BoolNull()
+	}
+	return result, diags
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceTimeAccountingSettings{}
+
+func TestAccBasicTimeAccountingSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTimeAccountingSettingsResourceConfig("hour"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_time_accounting_settings.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("zammad_time_accounting_settings.test", "unit", "hour"),
+					resource.TestCheckResourceAttr("zammad_time_accounting_settings.test", "types", "true"),
+					resource.TestCheckResourceAttr("zammad_time_accounting_settings.test", "selector.ticket.group_id.value.0", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_time_accounting_settings.test",
+				ImportState:       true,
+				ImportStateId:     "time_accounting",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTimeAccountingSettingsResourceConfig("quarter"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_time_accounting_settings.test", "unit", "quarter"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTimeAccountingSettingsResourceConfig(unit string) string {
+	return fmt.Sprintf(`
+resource "zammad_time_accounting_settings" "test" {
+	unit  = "%s"
+	types = true
+	selector = {
+		"ticket.group_id" = {
+			operator = "is"
+			value    = ["1"]
+		}
+	}
+}
+`, unit)
+}
@@ -0,0 +1,281 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadTimeAccountingType() resource.Resource {
+	return &resourceTimeAccountingType{}
+}
+
+type resourceTimeAccountingType struct {
+	client *client.Client
+}
+
+// TimeAccountingType Resource schema
+func (r resourceTimeAccountingType) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the activity type. Zammad can not delete activity types, they are deactivated on destroy.",
+			},
+			"note": schema.StringAttribute{
+				Optional: true,
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceTimeAccountingType) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_time_accounting_type"
+}
+
+func (r *resourceTimeAccountingType) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceTimeAccountingType) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan TimeAccountingType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typereq := &client.TicketTimeAccountingType{
+		Name:   plan.Name.ValueString(),
+		Note:   plan.Note.ValueString(),
+		Active: plan.Active.ValueBool(),
+	}
+
+	tt, err := r.client.CreateTicketTimeAccountingType(typereq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating time accounting type",
+			"Could not create time accounting type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := TimeAccountingType{
+		ID:          types.StringValue(strconv.Itoa(tt.ID)),
+		Name:        types.StringValue(tt.Name),
+		Note:        types.StringValue(tt.Note),
+		Active:      types.BoolValue(tt.Active),
+		CreatedByID: types.Int64Value(int64(tt.CreatedByID)),
+		UpdatedByID: types.Int64Value(int64(tt.UpdatedByID)),
+		CreatedAt:   types.StringValue(tt.CreatedAt),
+		UpdatedAt:   types.StringValue(tt.UpdatedAt),
+	}
+	if plan.Note.IsNull() && tt.Note == "" {
+		result.Note = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceTimeAccountingType) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TimeAccountingType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typeID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	newtype, err := r.client.GetTicketTimeAccountingType(typeID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading time accounting type",
+			"Could not read time accounting type "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(newtype.Name)
+	state.Active = types.BoolValue(newtype.Active)
+	state.UpdatedAt = types.StringValue(newtype.UpdatedAt)
+	state.UpdatedByID = types.Int64Value(int64(newtype.UpdatedByID))
+	state.CreatedAt = types.StringValue(newtype.CreatedAt)
+	state.CreatedByID = types.Int64Value(int64(newtype.CreatedByID))
+	if state.Note.IsNull() && newtype.Note == "" {
+		state.Note = types.StringNull()
+	} else {
+		state.Note = types.StringValue(newtype.Note)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceTimeAccountingType) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TimeAccountingType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state TimeAccountingType
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typeID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	updatedType := &client.TicketTimeAccountingType{
+		ID:     typeID,
+		Name:   plan.Name.ValueString(),
+		Note:   plan.Note.ValueString(),
+		Active: plan.Active.ValueBool(),
+	}
+
+	tt, err := r.client.UpdateTicketTimeAccountingType(updatedType)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating time accounting type",
+			"Could not update time accounting type "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	result := TimeAccountingType{
+		ID:          types.StringValue(strconv.Itoa(tt.ID)),
+		Name:        types.StringValue(tt.Name),
+		Note:        types.StringValue(tt.Note),
+		Active:      types.BoolValue(tt.Active),
+		CreatedByID: types.Int64Value(int64(tt.CreatedByID)),
+		UpdatedByID: types.Int64Value(int64(tt.UpdatedByID)),
+		CreatedAt:   types.StringValue(tt.CreatedAt),
+		UpdatedAt:   types.StringValue(tt.UpdatedAt),
+	}
+	if plan.Note.IsNull() && tt.Note == "" {
+		result.Note = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deactivates the type, Zammad does not delete time accounting types
+func (r resourceTimeAccountingType) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TimeAccountingType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typeID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateTicketTimeAccountingType(&client.TicketTimeAccountingType{
+		ID:     typeID,
+		Name:   state.Name.ValueString(),
+		Note:   state.Note.ValueString(),
+		Active: false,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting time accounting type",
+			"Could not deactivate time accounting type "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceTimeAccountingType) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
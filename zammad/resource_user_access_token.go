@@ -0,0 +1,249 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadUserAccessToken() resource.Resource {
+	return &resourceUserAccessToken{}
+}
+
+type resourceUserAccessToken struct {
+	client *client.Client
+}
+
+// UserAccessToken Resource schema
+func (r resourceUserAccessToken) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "API token of the user the provider is authenticated as. Tokens can not be changed, use `terraform apply -replace` to rotate them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"permissions": schema.SetAttribute{
+				Required:      true,
+				ElementType:   types.StringType,
+				Description:   "Permissions of the token, e.g. ticket.agent or admin.user. The user must have these permissions.",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Date the token expires, as YYYY-MM-DD. The token does not expire if not set.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{stringMatchValidator{
+					re:      regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+					message: "a date in YYYY-MM-DD format",
+				}},
+			},
+			"token": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "The token. Zammad only returns it on creation, it is null after an import.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"last_used_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *resourceUserAccessToken) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_access_token"
+}
+
+func (r *resourceUserAccessToken) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceUserAccessToken) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan UserAccessToken
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newToken := &client.UserAccessToken{
+		Name: plan.Name.ValueString(),
+	}
+	resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &newToken.Permission, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.ExpiresAt.IsNull() {
+		expiresAt := plan.ExpiresAt.ValueString()
+		newToken.ExpiresAt = &expiresAt
+	}
+
+	token, err := r.client.CreateUserAccessToken(newToken)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user access token",
+			"Could not create user access token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := userAccessTokenFromClient(token, &plan)
+	result.Token = types.StringValue(token.Token)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceUserAccessToken) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserAccessToken
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	tokens, err := r.client.GetUserAccessTokens()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user access token",
+			"Could not read user access tokens: "+err.Error(),
+		)
+		return
+	}
+	var token *client.UserAccessToken
+	for i := range tokens {
+		if tokens[i].ID == id {
+			token = &tokens[i]
+		}
+	}
+	// Tokens are removed by Zammad once they expired.
+	if token == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := userAccessTokenFromClient(token, &state)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, all attributes require replacement
+func (r resourceUserAccessToken) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Error updating user access token",
+		"User access tokens can not be updated.",
+	)
+}
+
+// Delete resource
+func (r resourceUserAccessToken) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserAccessToken
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteUserAccessToken(&client.UserAccessToken{ID: id})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting user access token",
+			"Could not delete user access token "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceUserAccessToken) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func userAccessTokenFromClient(token *client.UserAccessToken, prior *UserAccessToken) *UserAccessToken {
+	permissions := make([]attr.Value, len(token.Permission))
+	for i := range token.Permission {
+		permissions[i] = types.StringValue(token.Permission[i])
+	}
+	result := &UserAccessToken{
+		ID:          types.StringValue(strconv.Itoa(token.ID)),
+		Name:        types.StringValue(token.Name),
+		Permissions: types.SetValueMust(types.StringType, permissions),
+		ExpiresAt:   types.StringNull(),
+		Token:       prior.Token,
+		LastUsedAt:  types.StringNull(),
+		CreatedAt:   types.StringValue(token.CreatedAt),
+	}
+	if token.ExpiresAt != nil {
+		result.ExpiresAt = types.StringValue(*token.ExpiresAt)
+	}
+	if token.LastUsedAt != nil {
+		result.LastUsedAt = types.StringValue(*token.LastUsedAt)
+	}
+	return result
+}
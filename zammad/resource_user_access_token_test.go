@@ -0,0 +1,57 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceUserAccessToken{}
+
+func TestAccBasicUserAccessTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "zammad_user_access_token" "test" {
+	name        = "terraform-test"
+	permissions = ["ticket.agent"]
+	expires_at  = "2099-12-31"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_user_access_token.test", "name", "terraform-test"),
+					resource.TestCheckResourceAttr("zammad_user_access_token.test", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("zammad_user_access_token.test", "expires_at", "2099-12-31"),
+					resource.TestCheckResourceAttrSet("zammad_user_access_token.test", "token"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "zammad_user_access_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,299 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// groupAccessLevels are the access levels a user can have on a group.
+var groupAccessLevels = []string{"read", "create", "change", "overview", "full"}
+
+func NewZammadUserGroupAccess() resource.Resource {
+	return &resourceUserGroupAccess{}
+}
+
+type resourceUserGroupAccess struct {
+	client *client.Client
+}
+
+// UserGroupAccess Resource schema
+func (r resourceUserGroupAccess) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Access of a user to a single group. The access to other groups of the user is left untouched, so the groups of a user can be managed from different configurations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The access as <user_id>:<group_id>, also used for import.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"user_id": schema.Int64Attribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"group_id": schema.Int64Attribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"access": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Access levels: " + strings.Join(groupAccessLevels, ", ") + ".",
+			},
+		},
+	}
+}
+
+func (r *resourceUserGroupAccess) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group_access"
+}
+
+func (r *resourceUserGroupAccess) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// ValidateConfig checks the access levels.
+func (r resourceUserGroupAccess) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var access types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("access"), &access)...)
+	if resp.Diagnostics.HasError() || access.IsNull() || access.IsUnknown() {
+		return
+	}
+	if len(access.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("access"), "Missing access", "At least one access level is required.")
+	}
+	var levels []types.String
+	resp.Diagnostics.Append(access.ElementsAs(ctx, &levels, true)...)
+	for _, l := range levels {
+		if !l.IsUnknown() && !stringInSlice(l.ValueString(), groupAccessLevels) {
+			resp.Diagnostics.AddAttributeError(path.Root("access"), "Invalid access level", fmt.Sprintf("%q is not one of %v.", l.ValueString(), groupAccessLevels))
+		}
+	}
+}
+
+// Create grants the access
+func (r resourceUserGroupAccess) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan UserGroupAccess
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceUserGroupAccess) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserGroupAccess
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.SplitN(state.ID.ValueString(), ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could not parse id "+state.ID.ValueString()+", expected <user_id>:<group_id>",
+		)
+		return
+	}
+	userID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	groupID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	user, err := r.client.GetUser(userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user group access",
+			"Could not read user "+parts[0]+": "+err.Error(),
+		)
+		return
+	}
+	access, ok := user.GroupIDs[parts[1]]
+	if !ok || len(access) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := userGroupAccessValue(userID, groupID, access)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update changes the access levels
+func (r resourceUserGroupAccess) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserGroupAccess
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the access to the group, other groups of the user are kept
+func (r resourceUserGroupAccess) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserGroupAccess
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := int(state.UserID.ValueInt64())
+	user, err := r.client.GetUser(userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting user group access",
+			"Could not read user "+strconv.Itoa(userID)+": "+err.Error(),
+		)
+		return
+	}
+
+	groupIDs := user.GroupIDs
+	if groupIDs == nil {
+		groupIDs = map[string][]string{}
+	}
+	delete(groupIDs, strconv.FormatInt(state.GroupID.ValueInt64(), 10))
+	_, err = r.client.UpdateUserGroupIDs(userID, groupIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting user group access",
+			"Could not update groups of user "+strconv.Itoa(userID)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceUserGroupAccess) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply reads the groups of the user and writes them back with the access to
+// the planned group replaced.
+func (r resourceUserGroupAccess) apply(ctx context.Context, plan UserGroupAccess) (*UserGroupAccess, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var access []string
+	diags.Append(plan.Access.ElementsAs(ctx, &access, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	sort.Strings(access)
+
+	userID := int(plan.UserID.ValueInt64())
+	groupID := int(plan.GroupID.ValueInt64())
+	user, err := r.client.GetUser(userID)
+	if err != nil {
+		diags.AddError(
+			"Error updating user group access",
+			"Could not read user "+strconv.Itoa(userID)+": "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	groupIDs := user.GroupIDs
+	if groupIDs == nil {
+		groupIDs = map[string][]string{}
+	}
+	groupIDs[strconv.Itoa(groupID)] = access
+	user, err = r.client.UpdateUserGroupIDs(userID, groupIDs)
+	if err != nil {
+		diags.AddError(
+			"Error updating user group access",
+			"Could not update groups of user "+strconv.Itoa(userID)+": "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	if updated, ok := user.GroupIDs[strconv.Itoa(groupID)]; ok {
+		access = updated
+	}
+	return userGroupAccessValue(userID, groupID, access), diags
+}
+
+func userGroupAccessValue(userID, groupID int, access []string) *UserGroupAccess {
+	elems := make([]attr.Value, len(access))
+	for i := range access {
+		elems[i] = types.StringValue(access[i])
+	}
+	return &UserGroupAccess{
+		ID:      types.StringValue(fmt.Sprintf("%d:%d", userID, groupID)),
+		UserID:  types.Int64Value(int64(userID)),
+		GroupID: types.Int64Value(int64(groupID)),
+		Access:  types.SetValueMust(types.StringType, elems),
+	}
+}
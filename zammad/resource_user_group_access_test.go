@@ -0,0 +1,72 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceUserGroupAccess{}
+
+func TestAccBasicUserGroupAccessResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserGroupAccessResourceConfig(`"read", "delete"`),
+				ExpectError: regexp.MustCompile("Invalid access level"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccUserGroupAccessResourceConfig(`"read", "create"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_user_group_access.test", "id", "1:1"),
+					resource.TestCheckResourceAttr("zammad_user_group_access.test", "access.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zammad_user_group_access.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccUserGroupAccessResourceConfig(`"full"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_user_group_access.test", "access.#", "1"),
+					resource.TestCheckResourceAttr("zammad_user_group_access.test", "access.0", "full"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUserGroupAccessResourceConfig(access string) string {
+	return fmt.Sprintf(`
+resource "zammad_user_group_access" "test" {
+	user_id  = 1
+	group_id = 1
+	access   = [%s]
+}
+`, access)
+}
@@ -0,0 +1,331 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+func NewZammadWebhook() resource.Resource {
+	return &resourceWebhook{}
+}
+
+type resourceWebhook struct {
+	client *client.Client
+}
+
+// Webhook Resource schema
+func (r resourceWebhook) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"endpoint": schema.StringAttribute{
+				Required:    true,
+				Description: "URL the webhook is sent to.",
+				Validators:  []validator.String{urlValidator{}},
+			},
+			"signature_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Token used to sign the payload, sent in the X-Hub-Signature header.",
+			},
+			"ssl_verify": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Verify the TLS certificate of the endpoint. Defaults to true.",
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"basic_auth_username": schema.StringAttribute{
+				Optional: true,
+			},
+			"basic_auth_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"custom_payload": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSON payload sent instead of the default payload. Placeholders such as #{ticket.title} can be used in strings.",
+				Validators:  []validator.String{jsonValidator{}},
+			},
+			"pre_defined_webhook_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Payload format of a pre-defined webhook: Mattermost, RocketChat, Slack or MicrosoftTeams. MicrosoftTeams is how the Microsoft Teams integration is configured, it has no integration setting in Zammad.",
+				Validators: []validator.String{stringOneOfValidator{
+					values: []string{"Mattermost", "RocketChat", "Slack", "MicrosoftTeams"},
+				}},
+			},
+			"note": schema.StringAttribute{
+				Optional: true,
+			},
+			"active": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{&defaultTrue{}, boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_by_id": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"updated_by_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceWebhook) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *resourceWebhook) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*client.Client)
+}
+
+// Create a new resource
+func (r resourceWebhook) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan Webhook
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	whreq := &client.Webhook{
+		Name:                  plan.Name.ValueString(),
+		Endpoint:              plan.Endpoint.ValueString(),
+		SignatureToken:        plan.SignatureToken.ValueString(),
+		SSLVerify:             plan.SSLVerify.ValueBool(),
+		BasicAuthUsername:     plan.BasicAuthUsername.ValueString(),
+		BasicAuthPassword:     plan.BasicAuthPassword.ValueString(),
+		CustomizedPayload:     !plan.CustomPayload.IsNull(),
+		CustomPayload:         plan.CustomPayload.ValueString(),
+		PreDefinedWebhookType: plan.PreDefinedWebhookType.ValueString(),
+		Note:                  plan.Note.ValueString(),
+		Active:                plan.Active.ValueBool(),
+	}
+
+	wh, err := r.client.CreateWebhook(whreq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating webhook",
+			"Could not create webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, webhookFromClient(wh, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r resourceWebhook) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Webhook
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	whID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	wh, err := r.client.GetWebhook(whID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading webhook",
+			"Could not read webhook "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, webhookFromClient(wh, state))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resource
+func (r resourceWebhook) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Webhook
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state Webhook
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	whID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	updatedWH := &client.Webhook{
+		ID:                    whID,
+		Name:                  plan.Name.ValueString(),
+		Endpoint:              plan.Endpoint.ValueString(),
+		SignatureToken:        plan.SignatureToken.ValueString(),
+		SSLVerify:             plan.SSLVerify.ValueBool(),
+		BasicAuthUsername:     plan.BasicAuthUsername.ValueString(),
+		BasicAuthPassword:     plan.BasicAuthPassword.ValueString(),
+		CustomizedPayload:     !plan.CustomPayload.IsNull(),
+		CustomPayload:         plan.CustomPayload.ValueString(),
+		PreDefinedWebhookType: plan.PreDefinedWebhookType.ValueString(),
+		Note:                  plan.Note.ValueString(),
+		Active:                plan.Active.ValueBool(),
+	}
+
+	wh, err := r.client.UpdateWebhook(updatedWH)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating webhook",
+			"Could not update webhook "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, webhookFromClient(wh, plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r resourceWebhook) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Webhook
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	whID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ID",
+			"Could convert id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteWebhook(&client.Webhook{ID: whID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting webhook",
+			"Could not delete webhook "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Import resource
+func (r resourceWebhook) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func webhookFromClient(wh *client.Webhook, prior Webhook) Webhook {
+	result := Webhook{
+		ID:                    types.StringValue(strconv.Itoa(wh.ID)),
+		Name:                  types.StringValue(wh.Name),
+		Endpoint:              types.StringValue(wh.Endpoint),
+		SignatureToken:        types.StringValue(wh.SignatureToken),
+		SSLVerify:             types.BoolValue(wh.SSLVerify),
+		BasicAuthUsername:     types.StringValue(wh.BasicAuthUsername),
+		BasicAuthPassword:     types.StringValue(wh.BasicAuthPassword),
+		CustomPayload:         types.StringValue(wh.CustomPayload),
+		PreDefinedWebhookType: types.StringValue(wh.PreDefinedWebhookType),
+		Note:                  types.StringValue(wh.Note),
+		Active:                types.BoolValue(wh.Active),
+		CreatedByID:           types.Int64Value(int64(wh.CreatedByID)),
+		UpdatedByID:           types.Int64Value(int64(wh.UpdatedByID)),
+		CreatedAt:             types.StringValue(wh.CreatedAt),
+		UpdatedAt:             types.StringValue(wh.UpdatedAt),
+	}
+	if prior.SignatureToken.IsNull() && wh.SignatureToken == "" {
+		result.SignatureToken = types.StringNull()
+	}
+	if prior.BasicAuthUsername.IsNull() && wh.BasicAuthUsername == "" {
+		result.BasicAuthUsername = types.StringNull()
+	}
+	if prior.BasicAuthPassword.IsNull() && wh.BasicAuthPassword == "" {
+		result.BasicAuthPassword = types.StringNull()
+	}
+	// The payload is kept as configured if it is the same JSON document.
+	if !wh.CustomizedPayload || wh.CustomPayload == "" {
+		if prior.CustomPayload.IsNull() {
+			result.CustomPayload = types.StringNull()
+		}
+	} else if jsonEqual(prior.CustomPayload.ValueString(), wh.CustomPayload) {
+		result.CustomPayload = prior.CustomPayload
+	}
+	if prior.PreDefinedWebhookType.IsNull() && wh.PreDefinedWebhookType == "" {
+		result.PreDefinedWebhookType = types.StringNull()
+	}
+	if prior.Note.IsNull() && wh.Note == "" {
+		result.Note = types.StringNull()
+	}
+	return result
+}
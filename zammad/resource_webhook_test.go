@@ -0,0 +1,89 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var _ tfresource.ResourceWithSchema = &resourceWebhook{}
+
+func TestAccBasicWebhookResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookResourceConfig("one", "https://example.com/hook", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_webhook.test", "name", "one"),
+					resource.TestCheckResourceAttr("zammad_webhook.test", "endpoint", "https://example.com/hook"),
+					resource.TestCheckResourceAttr("zammad_webhook.test", "ssl_verify", "true"),
+					resource.TestCheckResourceAttr("zammad_webhook.test", "signature_token", "secret"),
+					resource.TestCheckResourceAttr("zammad_webhook.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "zammad_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"custom_payload"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccWebhookResourceConfig("two", "https://example.org/hook", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zammad_webhook.test", "name", "two"),
+					resource.TestCheckResourceAttr("zammad_webhook.test", "endpoint", "https://example.org/hook"),
+					resource.TestCheckResourceAttr("zammad_webhook.test", "ssl_verify", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInvalidEndpointWebhookResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebhookResourceConfig("one", "ftp://example.com/hook", true),
+				ExpectError: regexp.MustCompile("Invalid URL"),
+			},
+		},
+	})
+}
+
+func testAccWebhookResourceConfig(name, endpoint string, sslVerify bool) string {
+	return fmt.Sprintf(`
+resource "zammad_webhook" "test" {
+	name            = "%s"
+	endpoint        = "%s"
+	ssl_verify      = %t
+	signature_token = "secret"
+	custom_payload  = jsonencode({
+		title = "#{ticket.title}"
+	})
+}
+`, name, endpoint, sslVerify)
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/o11ydev/terraform-provider-zammad/internal/client"
+)
+
+// settingValue is a value to write to the setting name.
+type settingValue struct {
+	name  string
+	value interface{}
+}
+
+// updateSettings writes the values to the settings in the given order and
+// returns the updated settings, keyed by name. title names the settings in
+// diagnostics.
+func updateSettings(c *client.Client, title string, values ...settingValue) (map[string]*client.Setting, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = v.name
+	}
+	settings, err := c.GetSettingsByName(names...)
+	if err != nil {
+		diags.AddError(
+			"Error reading "+title,
+			"Could not read settings: "+err.Error(),
+		)
+		return nil, diags
+	}
+	for _, v := range values {
+		value, err := json.Marshal(v.value)
+		if err != nil {
+			diags.AddError("Error encoding setting", "Could not encode "+v.name+": "+err.Error())
+			return nil, diags
+		}
+		setting, err := c.UpdateSetting(settings[v.name].ID, value)
+		if err != nil {
+			diags.AddError(
+				"Error updating "+title,
+				"Could not update setting "+v.name+": "+err.Error(),
+			)
+			return nil, diags
+		}
+		settings[v.name] = setting
+	}
+	return settings, diags
+}
+
+// decodeSetting decodes the value of a setting into v, unset values leave v
+// untouched.
+func decodeSetting(setting *client.Setting, v interface{}, diags *diag.Diagnostics) {
+	value := setting.StateCurrent.Value
+	if len(value) == 0 || string(value) == "null" {
+		return
+	}
+	if err := json.Unmarshal(value, v); err != nil {
+		diags.AddError("Error decoding setting", "Could not decode "+setting.Name+": "+err.Error())
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 The Terraform Provider for Zammad Authors
+// spdx-license-identifier: apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zammad
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// int64PointerValue converts an optional ID to the nullable form used by the
+// client.
+func int64PointerValue(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// int64PointerToValue converts a nullable ID of the client to an optional
+// attribute value.
+func int64PointerToValue(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*i))
+}
+
+// int64SetValue converts a list of IDs to a set attribute value.
+func int64SetValue(ids []int) types.Set {
+	elems := make([]attr.Value, len(ids))
+	for i := range ids {
+		elems[i] = types.Int64Value(int64(ids[i]))
+	}
+	return types.SetValueMust(types.Int64Type, elems)
+}
+
+// stringListValue converts strings to a list attribute value.
+func stringListValue(values []string) types.List {
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.StringValue(v)
+	}
+	return types.ListValueMust(types.StringType, elems)
+}